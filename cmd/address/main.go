@@ -3,6 +3,7 @@ package main
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
@@ -10,11 +11,13 @@ import (
 	"strings"
 
 	"github.com/study/crypto-accounts/pkgs/address"
+	"github.com/study/crypto-accounts/pkgs/bip32"
 	"github.com/study/crypto-accounts/pkgs/bip39"
 	"github.com/study/crypto-accounts/pkgs/bip44"
 	"github.com/study/crypto-accounts/pkgs/crypto/ed25519"
 	"github.com/study/crypto-accounts/pkgs/crypto/rsa"
 	"github.com/study/crypto-accounts/pkgs/crypto/secp256k1"
+	"github.com/study/crypto-accounts/pkgs/keystore"
 )
 
 const usage = `Address Generation CLI Tool
@@ -27,6 +30,8 @@ Commands:
   validate    Validate an address
   chains      List supported chains
   info        Show chain information
+  explain     Show every step of a mnemonic-to-address derivation
+  report      List the first addresses a mnemonic controls on several chains
 
 Examples:
   # Generate Bitcoin address from private key
@@ -35,23 +40,50 @@ Examples:
   # Generate Ethereum address from private key
   address generate --chain eth --privkey e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35
 
+  # Generate the legacy P2PKH address of an uncompressed key (differs from the compressed one)
+  address generate --chain btc --uncompressed --privkey e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35
+
+  # Generate addresses on every compatible chain
+  address generate --chain all --privkey e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35
+
   # Generate addresses from mnemonic
   address generate --chain eth --mnemonic "abandon abandon ... about" --count 5
 
+  # Generate a native SegWit address at a custom path
+  address generate --chain btc --format p2wpkh --mnemonic "abandon abandon ... about" --path "m/84'/0'/1'/0/0"
+
+  # List watch-only receive addresses of an account xpub
+  address generate --chain btc --xpub xpub6BosfCnifzxcFwrSzQiqu2DBVTshkCXacvNsWGYJVVhhawA7d4R5WSWGFNbi8Aw6ZRc1brxMyWMzG3DSSSSoekkudhUd9yLb6qx39T9nMdj --count 5
+
   # Generate Arweave address with new RSA key
   address generate --chain ar --generate-rsa
 
   # Generate Arweave address from JWK file
   address generate --chain ar --jwk wallet.json
 
+  # Generate an Ethereum address from a keystore file, password in $PW
+  address generate --chain eth --keystore UTC--wallet.json --password-env PW
+
   # Validate an address
   address validate --chain btc --address 1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2
 
+  # List every chain an address is valid on
+  address validate --address 0x9858EfFD232B4033E47d90003D41EC34EcaEda94
+
+  # Validate an Ethereum address, rejecting a bad EIP-55 checksum
+  address validate --chain eth --strict --address 0x9858EfFD232B4033E47d90003D41EC34EcaEda94
+
   # List supported chains
   address chains
 
   # Show chain info
   address info --chain eth
+
+  # Explain how an address is derived
+  address explain --chain eth --mnemonic "abandon abandon ... about" --index 3
+
+  # List the first 3 addresses of a mnemonic on several chains
+  address report --mnemonic "abandon abandon ... about" --chains btc,eth,sol --count 3 --json
 `
 
 func main() {
@@ -69,6 +101,10 @@ func main() {
 		cmdChains(os.Args[2:])
 	case "info":
 		cmdInfo(os.Args[2:])
+	case "explain":
+		cmdExplain(os.Args[2:])
+	case "report":
+		cmdReport(os.Args[2:])
 	case "help", "-h", "--help":
 		fmt.Print(usage)
 	default:
@@ -80,18 +116,24 @@ func main() {
 
 func cmdGenerate(args []string) {
 	fs := flag.NewFlagSet("generate", flag.ExitOnError)
-	chain := fs.String("chain", "", "Chain ID (btc, eth, sol, etc.)")
+	chain := fs.String("chain", "", "Chain ID (btc, eth, sol, etc., or all)")
 	privkey := fs.String("privkey", "", "Private key in hex (32 bytes)")
 	pubkey := fs.String("pubkey", "", "Public key in hex (advanced)")
+	xpub := fs.String("xpub", "", "Account extended public key for watch-only receive addresses")
 	mnemonic := fs.String("mnemonic", "", "BIP-39 mnemonic phrase")
 	passphrase := fs.String("passphrase", "", "BIP-39 passphrase")
 	account := fs.Uint("account", 0, "BIP-44 account index")
 	count := fs.Uint("count", 1, "Number of addresses to generate")
+	path := fs.String("path", "", "Derivation path for --mnemonic (default: the chain's standard path)")
 	format := fs.String("format", "", "Address format (e.g., p2pkh, p2sh, bech32 for Bitcoin)")
+	uncompressed := fs.Bool("uncompressed", false, "Hash the uncompressed public key (legacy Bitcoin P2PKH; gives a different address)")
 	// RSA options for Arweave
 	generateRSA := fs.Bool("generate-rsa", false, "Generate new RSA key (for Arweave)")
 	jwkFile := fs.String("jwk", "", "Path to JWK file (for Arweave)")
 	saveJWK := fs.String("save-jwk", "", "Save generated RSA key to JWK file")
+	// Keystore options for EVM chains
+	keystoreFile := fs.String("keystore", "", "Path to a V3 keystore JSON file (EVM chains)")
+	passwordEnv := fs.String("password-env", "", "Environment variable holding the keystore password")
 	fs.Parse(args)
 
 	if *chain == "" {
@@ -101,6 +143,21 @@ func cmdGenerate(args []string) {
 
 	chainID := address.ChainID(strings.ToLower(*chain))
 
+	if *uncompressed && chainID != address.ChainBitcoin {
+		fmt.Println("Error: --uncompressed is only supported for Bitcoin (btc)")
+		os.Exit(1)
+	}
+
+	// Every compatible chain from one private key
+	if chainID == "all" {
+		if *privkey == "" {
+			fmt.Println("Error: --chain all requires --privkey")
+			os.Exit(1)
+		}
+		generateAllFromPrivkey(*privkey)
+		return
+	}
+
 	// RSA key generation for Arweave
 	if *generateRSA {
 		if chainID != address.ChainArweave {
@@ -121,15 +178,31 @@ func cmdGenerate(args []string) {
 		return
 	}
 
+	// Generate from a V3 keystore file (EVM chains)
+	if *keystoreFile != "" {
+		if _, ok := address.EVMChains()[chainID]; !ok {
+			fmt.Println("Error: --keystore is only supported for EVM chains")
+			os.Exit(1)
+		}
+		generateFromKeystore(chainID, *keystoreFile, *passwordEnv)
+		return
+	}
+
 	// Generate from private key (recommended)
 	if *privkey != "" {
-		generateFromPrivkey(chainID, *privkey, *format)
+		generateFromPrivkey(chainID, *privkey, *format, *uncompressed)
 		return
 	}
 
 	// Generate from mnemonic
 	if *mnemonic != "" {
-		generateFromMnemonic(chainID, *mnemonic, *passphrase, uint32(*account), uint32(*count), *format)
+		generateFromMnemonic(chainID, *mnemonic, *passphrase, *path, uint32(*account), uint32(*count), *format, *uncompressed)
+		return
+	}
+
+	// Watch-only receive addresses from an account xpub
+	if *xpub != "" {
+		generateFromXPub(chainID, *xpub, uint32(*count))
 		return
 	}
 
@@ -147,10 +220,32 @@ func cmdGenerate(args []string) {
 		os.Exit(1)
 	}
 
-	fmt.Println("Error: --privkey, --mnemonic, or --pubkey is required")
+	fmt.Println("Error: --privkey, --mnemonic, --xpub or --pubkey is required")
 	os.Exit(1)
 }
 
+// generateFromXPub derives receive addresses 0/0..count-1 below an account xpub
+func generateFromXPub(chainID address.ChainID, xpub string, count uint32) {
+	key, err := bip32.ParseExtendedKey(xpub)
+	if err != nil {
+		fmt.Printf("Error: invalid extended key: %v\n", err)
+		os.Exit(1)
+	}
+
+	addresses, err := bip44.WatchOnlyAddresses(key, chainID, count)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("=== %s Watch-Only Addresses ===\n\n", strings.ToUpper(string(chainID)))
+	for _, a := range addresses {
+		fmt.Printf("Path: %s\n", a.Path)
+		fmt.Printf("  Address: %s\n", a.Address)
+		fmt.Printf("  Public Key: %s\n\n", hex.EncodeToString(a.PublicKey))
+	}
+}
+
 func generateFromPubkey(chainID address.ChainID, pubkeyHex, format string) {
 	pubkey, err := hex.DecodeString(pubkeyHex)
 	if err != nil {
@@ -193,7 +288,7 @@ func generateFromPubkey(chainID address.ChainID, pubkeyHex, format string) {
 	fmt.Printf("Address: %s\n", addr)
 }
 
-func generateFromMnemonic(chainID address.ChainID, mnemonic, passphrase string, accountIdx, count uint32, format string) {
+func generateFromMnemonic(chainID address.ChainID, mnemonic, passphrase, path string, accountIdx, count uint32, format string, uncompressed bool) {
 	if !bip39.ValidateMnemonic(mnemonic) {
 		fmt.Println("Error: invalid mnemonic")
 		os.Exit(1)
@@ -201,38 +296,59 @@ func generateFromMnemonic(chainID address.ChainID, mnemonic, passphrase string,
 
 	// Check if this is an Ed25519 chain
 	if isEd25519Chain(chainID) {
-		generateFromMnemonicEd25519(chainID, mnemonic, passphrase, accountIdx, count)
+		generateFromMnemonicEd25519(chainID, mnemonic, passphrase, mnemonicPaths(chainID, "", path, accountIdx, count), accountIdx)
 		return
 	}
 
-	// secp256k1 chains use BIP-44
-	generateFromMnemonicSecp256k1(chainID, mnemonic, passphrase, accountIdx, count, format)
+	// secp256k1 chains use BIP-32
+	generateFromMnemonicSecp256k1(chainID, mnemonic, passphrase, mnemonicPaths(chainID, format, path, accountIdx, count), accountIdx, format, uncompressed)
+}
+
+// mnemonicPaths returns the derivation paths to generate: the --path value if
+// given, otherwise the chain's standard path for each address index.
+func mnemonicPaths(chainID address.ChainID, format, path string, accountIdx, count uint32) []string {
+	if path != "" {
+		return []string{path}
+	}
+
+	// Ed25519 schemes without an index level enumerate accounts instead
+	scheme, err := address.Ed25519DerivationScheme(chainID)
+	byAccount := err == nil && scheme.EnumeratesAccounts()
+
+	paths := make([]string, 0, count)
+	for i := uint32(0); i < count; i++ {
+		account, index := accountIdx, i
+		if byAccount {
+			account, index = accountIdx+i, 0
+		}
+		p, err := address.DerivationPath(chainID, format, account, index)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		paths = append(paths, p)
+	}
+	return paths
 }
 
 // generateFromMnemonicEd25519 generates addresses for Ed25519 chains using SLIP-10
-func generateFromMnemonicEd25519(chainID address.ChainID, mnemonic, passphrase string, accountIdx, count uint32) {
+func generateFromMnemonicEd25519(chainID address.ChainID, mnemonic, passphrase string, paths []string, accountIdx uint32) {
 	// Generate seed from mnemonic
 	seed := bip39.NewSeed(mnemonic, passphrase)
 
-	// Get coin type for the chain
-	coinType := chainToCoinTypeEd25519(chainID)
-
 	fmt.Printf("=== %s Addresses (Ed25519/SLIP-10) ===\n", strings.ToUpper(string(chainID)))
 	fmt.Printf("Account: %d\n", accountIdx)
 	fmt.Printf("Curve: Ed25519\n\n")
 
-	for i := uint32(0); i < count; i++ {
-		// SLIP-10 path: m/44'/coin_type'/account'/change'/address_index'
-		// All components are hardened for Ed25519
-		path := []uint32{
-			0x80000000 + 44,           // 44' (purpose)
-			0x80000000 + coinType,     // coin_type'
-			0x80000000 + accountIdx,   // account'
-			0x80000000 + 0,            // change' (0 = external)
-			0x80000000 + i,            // address_index'
+	for _, path := range paths {
+		indices, err := bip32.ParsePath(path)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
 		}
 
-		privkey, pubkey, err := ed25519.DeriveKeyFromPath(seed, path)
+		// All SLIP-10 path components are hardened for Ed25519
+		privkey, pubkey, err := ed25519.DeriveKeyFromPath(seed, indices)
 		if err != nil {
 			fmt.Printf("Error deriving key: %v\n", err)
 			continue
@@ -244,119 +360,162 @@ func generateFromMnemonicEd25519(chainID address.ChainID, mnemonic, passphrase s
 			continue
 		}
 
-		pathStr := fmt.Sprintf("m/44'/%d'/%d'/0'/%d'", coinType, accountIdx, i)
-		fmt.Printf("Path: %s\n", pathStr)
+		fmt.Printf("Path: %s\n", path)
 		fmt.Printf("  Address: %s\n", addr)
 		fmt.Printf("  Public Key: %s\n", hex.EncodeToString(pubkey))
 		fmt.Printf("  Private Key: %s\n\n", hex.EncodeToString(privkey))
 	}
 }
 
-// generateFromMnemonicSecp256k1 generates addresses for secp256k1 chains using BIP-44
-func generateFromMnemonicSecp256k1(chainID address.ChainID, mnemonic, passphrase string, accountIdx, count uint32, format string) {
+// generateFromMnemonicSecp256k1 generates addresses for secp256k1 chains using BIP-32
+func generateFromMnemonicSecp256k1(chainID address.ChainID, mnemonic, passphrase string, paths []string, accountIdx uint32, format string, uncompressed bool) {
 	wallet, err := bip44.NewWalletFromMnemonic(mnemonic, passphrase)
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 		os.Exit(1)
 	}
 
-	// Map chain ID to BIP-44 coin type
-	coinType := chainToCoinType(chainID)
-	if coinType == 0 && chainID != address.ChainBitcoin {
-		fmt.Printf("Error: chain %s not supported for BIP-44 derivation\n", chainID)
-		os.Exit(1)
+	// Bitcoin's --format picks both the path standard and the address type
+	script := ""
+	if chainID == address.ChainBitcoin {
+		script, err = address.BitcoinScriptType(format)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		if uncompressed && script != address.BitcoinScriptP2PKH {
+			fmt.Println("Error: --uncompressed only applies to P2PKH addresses")
+			os.Exit(1)
+		}
 	}
 
 	fmt.Printf("=== %s Addresses (secp256k1/BIP-44) ===\n", strings.ToUpper(string(chainID)))
 	fmt.Printf("Account: %d\n", accountIdx)
 	fmt.Printf("Curve: secp256k1\n\n")
 
-	for i := uint32(0); i < count; i++ {
-		path := bip44.NewPath(coinType, accountIdx, 0, i)
-		key, err := wallet.DeriveKey(path)
+	for _, path := range paths {
+		key, err := wallet.DeriveKeyFromString(path)
 		if err != nil {
 			fmt.Printf("Error deriving key: %v\n", err)
 			continue
 		}
 
-		// Get public key based on chain type
 		var pubkey []byte
 		var addr string
-
-		switch chainID {
-		case address.ChainEthereum, address.ChainBSC, address.ChainPolygon,
-			address.ChainFantom, address.ChainOptimism, address.ChainArbitrum,
-			address.ChainVeChain, address.ChainTheta, address.ChainTron:
-			// EVM chains need uncompressed public key
-			compressedKey := key.PublicKeyBytes()
-			pubkey, err = decompressPublicKey(compressedKey)
-			if err != nil {
-				fmt.Printf("Error decompressing public key: %v\n", err)
-				continue
-			}
-			addr, err = address.Generate(chainID, pubkey)
-
-		default:
-			// Most chains use compressed public key
+		if script != "" && script != address.BitcoinScriptP2PKH {
 			pubkey = key.PublicKeyBytes()
-			addr, err = address.Generate(chainID, pubkey)
+			var addresses map[string]string
+			addresses, err = address.NewBitcoinAddress(false).AllAddresses(pubkey)
+			addr = addresses[script]
+		} else {
+			pubkey, addr, err = secp256k1Address(chainID, key.PublicKeyBytes(), uncompressed)
 		}
-
 		if err != nil {
 			fmt.Printf("Error generating address: %v\n", err)
 			continue
 		}
 
-		fmt.Printf("Path: %s\n", path.String())
+		fmt.Printf("Path: %s\n", path)
 		fmt.Printf("  Address: %s\n", addr)
 		fmt.Printf("  Public Key: %s\n\n", hex.EncodeToString(pubkey))
 	}
 }
 
-// chainToCoinTypeEd25519 returns the coin type for Ed25519 chains
-func chainToCoinTypeEd25519(chainID address.ChainID) uint32 {
-	switch chainID {
-	case address.ChainSolana:
-		return 501
-	case address.ChainStellar:
-		return 148
-	case address.ChainAlgorand:
-		return 283
-	case address.ChainNEAR:
-		return 397
-	case address.ChainAptos:
-		return 637
-	case address.ChainSui:
-		return 784
-	case address.ChainCardano:
-		return 1815
-	default:
-		return 0
+// secp256k1Address generates an address from a compressed secp256k1 public key,
+// returning the public key it was generated from. uncompressed passes the
+// 65-byte key instead, for chains that hash either form, such as Bitcoin P2PKH.
+func secp256k1Address(chainID address.ChainID, compressedKey []byte, uncompressed bool) ([]byte, string, error) {
+	pubkey := compressedKey
+	if uncompressed {
+		var err error
+		pubkey, err = decompressPublicKey(compressedKey)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	addr, err := address.Generate(chainID, pubkey)
+	if err != nil {
+		return nil, "", err
 	}
+
+	return pubkey, addr, nil
 }
 
 func cmdValidate(args []string) {
 	fs := flag.NewFlagSet("validate", flag.ExitOnError)
-	chain := fs.String("chain", "", "Chain ID (btc, eth, sol, etc.)")
+	chain := fs.String("chain", "", "Chain ID (btc, eth, sol, etc.); omit to check every chain")
 	addr := fs.String("address", "", "Address to validate")
+	testnet := fs.Bool("testnet", false, "Validate against testnet address formats")
+	strict := fs.Bool("strict", false, "Require a correct EIP-55 checksum on mixed-case EVM addresses")
 	fs.Parse(args)
 
-	if *chain == "" || *addr == "" {
-		fmt.Println("Error: --chain and --address are required")
+	if *addr == "" {
+		fmt.Println("Error: --address is required")
 		os.Exit(1)
 	}
 
+	factory := address.DefaultFactory
+	if *testnet {
+		factory = address.NewTestnetFactory()
+	}
+
+	// Without a chain, report every chain that accepts the address
+	if *chain == "" {
+		if *strict {
+			fmt.Println("Error: --strict requires --chain")
+			os.Exit(1)
+		}
+		validateAllChains(factory, *addr)
+		return
+	}
+
 	chainID := address.ChainID(strings.ToLower(*chain))
 
-	valid := address.Validate(chainID, *addr)
+	valid := factory.Validate(chainID, *addr)
+	if *strict {
+		gen, err := factory.Get(chainID)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		strictGen, ok := gen.(interface{ ValidateStrict(string) bool })
+		if !ok {
+			fmt.Println("Error: --strict is only supported for EVM chains")
+			os.Exit(1)
+		}
+		valid = strictGen.ValidateStrict(*addr)
+	}
+
 	if valid {
 		fmt.Printf("✓ Valid %s address\n", strings.ToUpper(string(chainID)))
 	} else {
 		fmt.Printf("✗ Invalid %s address\n", strings.ToUpper(string(chainID)))
+		if network, err := factory.NetworkOf(chainID, *addr); err == nil && network != factory.Network() {
+			fmt.Printf("  Warning: this is a valid %s address, but it was checked against %s\n", network, factory.Network())
+		}
 		os.Exit(1)
 	}
 }
 
+// validateAllChains prints every chain whose validator accepts addr
+func validateAllChains(factory *address.Factory, addr string) {
+	chains := factory.DetectChains(addr)
+	if len(chains) == 0 {
+		fmt.Println("✗ Not a valid address on any supported chain")
+		os.Exit(1)
+	}
+
+	fmt.Printf("✓ Valid on %d chain(s):\n", len(chains))
+	for _, chainID := range chains {
+		name := ""
+		if info, err := factory.Info(chainID); err == nil {
+			name = info.Name
+		}
+		fmt.Printf("  %-8s %s\n", chainID, name)
+	}
+}
+
 func cmdChains(args []string) {
 	infos := address.ListAllChainInfo()
 
@@ -403,40 +562,163 @@ func cmdInfo(args []string) {
 	fmt.Println()
 }
 
-// decompressPublicKey decompresses a secp256k1 public key
-func decompressPublicKey(compressed []byte) ([]byte, error) {
-	if len(compressed) != 33 {
-		return nil, fmt.Errorf("invalid compressed public key length")
+func cmdExplain(args []string) {
+	fs := flag.NewFlagSet("explain", flag.ExitOnError)
+	chain := fs.String("chain", "", "Chain ID (btc, eth, etc.)")
+	mnemonic := fs.String("mnemonic", "", "BIP-39 mnemonic phrase")
+	passphrase := fs.String("passphrase", "", "BIP-39 passphrase")
+	account := fs.Uint("account", 0, "BIP-44 account index")
+	change := fs.Uint("change", 0, "BIP-44 change (0 = external, 1 = internal)")
+	index := fs.Uint("index", 0, "BIP-44 address index")
+	fs.Parse(args)
+
+	if *chain == "" || *mnemonic == "" {
+		fmt.Println("Error: --chain and --mnemonic are required")
+		os.Exit(1)
 	}
 
-	// Use our secp256k1 library to decompress
-	point, err := secp256k1.DecompressPoint(compressed)
+	if !bip39.ValidateMnemonic(*mnemonic) {
+		fmt.Println("Error: invalid mnemonic")
+		os.Exit(1)
+	}
+
+	chainID := address.ChainID(strings.ToLower(*chain))
+	if isEd25519Chain(chainID) {
+		fmt.Printf("Error: explain supports secp256k1 BIP-44 chains, %s uses Ed25519\n", chainID)
+		os.Exit(1)
+	}
+
+	coinType := chainToCoinType(chainID)
+	if coinType == 0 && chainID != address.ChainBitcoin {
+		fmt.Printf("Error: chain %s not supported for BIP-44 derivation\n", chainID)
+		os.Exit(1)
+	}
+
+	seed := bip39.NewSeed(*mnemonic, *passphrase)
+	path := bip44.NewPath(coinType, uint32(*account), uint32(*change), uint32(*index))
+
+	trace, err := bip44.TraceDerivation(seed, path)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	pubkey, addr, err := secp256k1Address(chainID, trace.PublicKey, false)
+	if err != nil {
+		fmt.Printf("Error generating address: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("=== %s Derivation (secp256k1/BIP-44) ===\n\n", strings.ToUpper(string(chainID)))
+	fmt.Printf("Seed:       %s\n", hex.EncodeToString(trace.Seed))
+	fmt.Printf("Master Key: %s\n\n", trace.MasterKey)
+
+	for _, step := range trace.Steps {
+		fmt.Printf("%s (index 0x%08x)\n", step.Path, step.Index)
+		fmt.Printf("  Extended Key: %s\n", step.ExtendedKey)
+		fmt.Printf("  Public Key:   %s\n", hex.EncodeToString(step.PublicKey))
+		fmt.Printf("  Fingerprint:  %s\n\n", hex.EncodeToString(step.Fingerprint))
+	}
+
+	fmt.Printf("Private Key: %s\n", hex.EncodeToString(trace.PrivateKey))
+	fmt.Printf("Public Key:  %s\n", hex.EncodeToString(pubkey))
+	fmt.Printf("Address:     %s\n", addr)
+}
+
+// reportEntry is one line of the report command's JSON output
+type reportEntry struct {
+	Chain     address.ChainID `json:"chain"`
+	Curve     address.Curve   `json:"curve"`
+	Path      string          `json:"path"`
+	Address   string          `json:"address"`
+	PublicKey string          `json:"public_key"`
+}
+
+func cmdReport(args []string) {
+	fs := flag.NewFlagSet("report", flag.ExitOnError)
+	mnemonic := fs.String("mnemonic", "", "BIP-39 mnemonic phrase")
+	passphrase := fs.String("passphrase", "", "BIP-39 passphrase")
+	chains := fs.String("chains", "btc,eth,sol", "Comma-separated chain IDs")
+	account := fs.Uint("account", 0, "Account index")
+	count := fs.Uint("count", 3, "Number of addresses per chain")
+	jsonOut := fs.Bool("json", false, "Print the report as JSON")
+	fs.Parse(args)
+
+	if *mnemonic == "" {
+		fmt.Println("Error: --mnemonic is required")
+		os.Exit(1)
+	}
+
+	wallet, err := bip44.NewWalletFromMnemonic(*mnemonic, *passphrase)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	var entries []reportEntry
+	for _, chain := range strings.Split(*chains, ",") {
+		chainID := address.ChainID(strings.ToLower(strings.TrimSpace(chain)))
+		addresses, err := wallet.ChainAddresses(chainID, uint32(*account), uint32(*count))
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		for _, a := range addresses {
+			entries = append(entries, reportEntry{a.ChainID, a.Curve, a.Path, a.Address, hex.EncodeToString(a.PublicKey)})
+		}
+	}
+
+	if *jsonOut {
+		out, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(out))
+		return
+	}
+
+	fmt.Printf("%-8s %-10s %-22s %s\n", "CHAIN", "CURVE", "PATH", "ADDRESS")
+	fmt.Println(strings.Repeat("-", 90))
+	for _, e := range entries {
+		fmt.Printf("%-8s %-10s %-22s %s\n", e.Chain, e.Curve, e.Path, e.Address)
+	}
+}
+
+// decompressPublicKey converts a secp256k1 public key in any encoding to uncompressed form
+func decompressPublicKey(pubkey []byte) ([]byte, error) {
+	point, err := secp256k1.ParseAnyPublicKey(pubkey)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decompress public key: %v", err)
 	}
 
-	return secp256k1.SerializeUncompressed(point), nil
+	_, uncompressed, _ := secp256k1.PublicKeyFormats(point)
+	return uncompressed, nil
 }
 
 // isEd25519Chain returns true if the chain uses Ed25519 curve
 func isEd25519Chain(chainID address.ChainID) bool {
-	switch chainID {
-	case address.ChainSolana, address.ChainStellar, address.ChainAlgorand,
-		address.ChainNEAR, address.ChainAptos, address.ChainSui, address.ChainCardano:
-		return true
-	default:
-		return false
-	}
+	return address.ChainCurves[chainID] == address.CurveEd25519
 }
 
 // generateFromPrivkey generates an address from a private key
-func generateFromPrivkey(chainID address.ChainID, privkeyHex, format string) {
+func generateFromPrivkey(chainID address.ChainID, privkeyHex, format string, uncompressed bool) {
 	privkey, err := hex.DecodeString(privkeyHex)
 	if err != nil {
 		fmt.Printf("Error: invalid private key hex: %v\n", err)
 		os.Exit(1)
 	}
 
+	// Ed25519 wallets sometimes export the 64-byte expanded key (seed || public key)
+	if isEd25519Chain(chainID) && len(privkey) == ed25519.ExpandedPrivateKeySize {
+		privkey, err = ed25519.SeedFromExpanded(privkey)
+		if err != nil {
+			fmt.Printf("Error: invalid expanded Ed25519 key: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
 	if len(privkey) != 32 {
 		fmt.Printf("Error: private key must be 32 bytes, got %d bytes\n", len(privkey))
 		os.Exit(1)
@@ -449,7 +731,56 @@ func generateFromPrivkey(chainID address.ChainID, privkeyHex, format string) {
 	}
 
 	// secp256k1 chains
-	generateFromPrivkeySecp256k1(chainID, privkey, format)
+	generateFromPrivkeySecp256k1(chainID, privkey, format, uncompressed)
+}
+
+// generateFromKeystore decrypts a V3 keystore file and generates its address.
+// The password is read from an environment variable so it stays out of shell history.
+func generateFromKeystore(chainID address.ChainID, path, passwordEnv string) {
+	if passwordEnv == "" {
+		fmt.Println("Error: --keystore requires --password-env")
+		os.Exit(1)
+	}
+	password, ok := os.LookupEnv(passwordEnv)
+	if !ok {
+		fmt.Printf("Error: environment variable %s is not set\n", passwordEnv)
+		os.Exit(1)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Printf("Error reading keystore file: %v\n", err)
+		os.Exit(1)
+	}
+
+	privkey, err := keystore.Decrypt(data, password)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	generateFromPrivkeySecp256k1(chainID, privkey, "", false)
+}
+
+// generateAllFromPrivkey generates addresses for every chain compatible with a private key
+func generateAllFromPrivkey(privkeyHex string) {
+	privkey, err := hex.DecodeString(privkeyHex)
+	if err != nil {
+		fmt.Printf("Error: invalid private key hex: %v\n", err)
+		os.Exit(1)
+	}
+
+	addresses, err := address.FromPrivateKey(privkey)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("%-8s %-10s %s\n", "CHAIN", "CURVE", "ADDRESS")
+	fmt.Println(strings.Repeat("-", 60))
+	for _, chainID := range address.SortedChains(addresses) {
+		fmt.Printf("%-8s %-10s %s\n", chainID, address.ChainCurves[chainID], addresses[chainID])
+	}
 }
 
 // generateFromPrivkeyEd25519 generates address for Ed25519 chains
@@ -476,11 +807,10 @@ func generateFromPrivkeyEd25519(chainID address.ChainID, privkey []byte) {
 }
 
 // generateFromPrivkeySecp256k1 generates address for secp256k1 chains
-func generateFromPrivkeySecp256k1(chainID address.ChainID, privkey []byte, format string) {
+func generateFromPrivkeySecp256k1(chainID address.ChainID, privkey []byte, format string, uncompressed bool) {
 	// Derive public key from private key
 	point := secp256k1.PrivateKeyToPublicKey(privkey)
-	compressedPubkey := secp256k1.CompressPoint(point)
-	uncompressedPubkey := secp256k1.SerializeUncompressed(point)
+	compressedPubkey, uncompressedPubkey, _ := secp256k1.PublicKeyFormats(point)
 
 	fmt.Printf("Private Key: %s\n", hex.EncodeToString(privkey))
 	fmt.Printf("Public Key (compressed): %s\n", hex.EncodeToString(compressedPubkey))
@@ -491,27 +821,45 @@ func generateFromPrivkeySecp256k1(chainID address.ChainID, privkey []byte, forma
 	// Handle special formats for Bitcoin
 	if chainID == address.ChainBitcoin {
 		btc := address.NewBitcoinAddress(false)
+
+		// Legacy wallets hashed the uncompressed key, which gives a different
+		// P2PKH address for the same private key. SegWit requires compressed keys.
+		btcPubkey := compressedPubkey
+		if uncompressed {
+			btcPubkey = uncompressedPubkey
+		}
+
 		switch strings.ToLower(format) {
 		case "p2pkh", "legacy", "":
-			addr, err := btc.P2PKH(compressedPubkey)
+			addr, err := btc.P2PKH(btcPubkey)
 			if err != nil {
 				fmt.Printf("Error: %v\n", err)
 				os.Exit(1)
 			}
 			fmt.Printf("P2PKH Address: %s\n", addr)
 		case "bech32", "segwit", "p2wpkh":
-			addr, err := btc.P2WPKH(compressedPubkey)
+			addr, err := btc.P2WPKH(btcPubkey)
 			if err != nil {
 				fmt.Printf("Error: %v\n", err)
 				os.Exit(1)
 			}
 			fmt.Printf("Bech32 Address: %s\n", addr)
 		case "all":
-			// Generate all address types
-			p2pkh, _ := btc.P2PKH(compressedPubkey)
-			p2wpkh, _ := btc.P2WPKH(compressedPubkey)
-			fmt.Printf("P2PKH Address:  %s\n", p2pkh)
-			fmt.Printf("Bech32 Address: %s\n", p2wpkh)
+			// SegWit and Taproot need the compressed key
+			if uncompressed {
+				p2pkh, _ := btc.P2PKH(btcPubkey)
+				fmt.Printf("P2PKH Address:       %s\n", p2pkh)
+				break
+			}
+			addresses, err := btc.AllAddresses(btcPubkey)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("P2PKH Address:       %s\n", addresses[address.BitcoinScriptP2PKH])
+			fmt.Printf("P2SH-P2WPKH Address: %s\n", addresses[address.BitcoinScriptP2SHP2WPKH])
+			fmt.Printf("P2WPKH Address:      %s\n", addresses[address.BitcoinScriptP2WPKH])
+			fmt.Printf("P2TR Address:        %s\n", addresses[address.BitcoinScriptP2TR])
 		default:
 			fmt.Printf("Unknown format: %s\n", format)
 			os.Exit(1)
@@ -519,49 +867,24 @@ func generateFromPrivkeySecp256k1(chainID address.ChainID, privkey []byte, forma
 		return
 	}
 
-	// Handle special chain cases
-	var pubkey []byte
+	// Generators take the compressed key and convert it as their chain needs
 	var addr string
 	var err error
 
 	switch chainID {
-	case address.ChainEthereum, address.ChainBSC, address.ChainPolygon,
-		address.ChainFantom, address.ChainOptimism, address.ChainArbitrum,
-		address.ChainVeChain, address.ChainTheta, address.ChainEthereumClassic,
-		address.ChainTron:
-		// Use uncompressed public key for EVM/TRON chains
-		pubkey = uncompressedPubkey
-		addr, err = address.Generate(chainID, pubkey)
-
 	case address.ChainTezos:
 		// Tezos with secp256k1 generates tz2 address
 		tezos := address.NewTezosAddressWithKeyType(address.TezosKeySecp256k1)
 		addr, err = tezos.GenerateTz2(compressedPubkey)
-		pubkey = compressedPubkey
-
-	case address.ChainFilecoin:
-		// Filecoin uses 65-byte uncompressed public key (0x04 + x + y)
-		// uncompressedPubkey from secp256k1.SerializeUncompressed already includes 0x04 prefix
-		pubkey = uncompressedPubkey
-		addr, err = address.Generate(chainID, pubkey)
 
 	case address.ChainMonero:
-		// Monero requires dual keys (spend + view), show warning
-		fmt.Println("Note: Monero requires both spend and view public keys (64 bytes total).")
-		fmt.Println("      Use --pubkey with 64-byte hex (spend_key || view_key) for proper address generation.")
-		fmt.Println("      Generating placeholder address with single key for demonstration:")
-		// Generate a placeholder address using the key twice
-		dualKey := append(compressedPubkey[:32], compressedPubkey[:32]...)
-		if len(dualKey) < 64 {
-			dualKey = append(dualKey, make([]byte, 64-len(dualKey))...)
-		}
-		pubkey = dualKey[:64]
-		addr, err = address.Generate(chainID, pubkey)
+		// Monero keys are not secp256k1 keys
+		fmt.Println("Error: Monero requires both spend and view public keys (64 bytes total).")
+		fmt.Println("       Use --pubkey with 64-byte hex (spend_key || view_key).")
+		os.Exit(1)
 
 	default:
-		// Most chains use compressed public key
-		pubkey = compressedPubkey
-		addr, err = address.Generate(chainID, pubkey)
+		addr, err = address.Generate(chainID, compressedPubkey)
 	}
 
 	if err != nil {
@@ -573,31 +896,8 @@ func generateFromPrivkeySecp256k1(chainID address.ChainID, privkey []byte, forma
 }
 
 func chainToCoinType(chainID address.ChainID) bip44.CoinType {
-	mapping := map[address.ChainID]bip44.CoinType{
-		address.ChainBitcoin:         bip44.CoinTypeBitcoin,
-		address.ChainEthereum:        bip44.CoinTypeEthereum,
-		address.ChainLitecoin:        bip44.CoinTypeLitecoin,
-		address.ChainDogecoin:        bip44.CoinTypeDogecoin,
-		address.ChainRipple:          bip44.CoinTypeRipple,
-		address.ChainBSC:             bip44.CoinTypeEthereum, // BSC uses ETH coin type
-		address.ChainPolygon:         bip44.CoinTypePolygon,
-		address.ChainSolana:          bip44.CoinTypeSolana,
-		address.ChainTron:            bip44.CoinTypeTron,
-		address.ChainCosmos:          bip44.CoinType(118),
-		address.ChainStellar:         bip44.CoinTypeStellar,
-		address.ChainBitcoinCash:     bip44.CoinTypeBitcoinCash,
-		address.ChainAvalanche:       bip44.CoinTypeAvalanche,
-		address.ChainBinanceBEP2:     bip44.CoinTypeBinance,
-		address.ChainFantom:          bip44.CoinTypeEthereum,
-		address.ChainOptimism:        bip44.CoinTypeEthereum,
-		address.ChainArbitrum:        bip44.CoinTypeEthereum,
-		address.ChainEthereumClassic: bip44.CoinTypeEthereumClassic,
-	}
-
-	if coinType, ok := mapping[chainID]; ok {
-		return coinType
-	}
-	return 0
+	coinType, _ := bip44.CoinTypeForChain(chainID)
+	return coinType
 }
 
 // generateArweaveWithNewRSA generates a new RSA key and creates an Arweave address
@@ -3,8 +3,10 @@ package main
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -34,6 +36,9 @@ Examples:
 
   # Show key info with public key
   bip32 info --key "xprv9s21ZrQH143K..."
+
+  # Derive from a testnet key and print JSON
+  bip32 derive --key "tprv8ZgxMBicQKsPd..." --path "m/84'/1'/0'" --format json
 `
 
 func main() {
@@ -103,8 +108,8 @@ func cmdGenerate(args []string) {
 	fmt.Printf("Network:     %s\n", net.Name)
 	fmt.Printf("Seed:        %s\n", *seedHex)
 	fmt.Println()
-	fmt.Printf("xprv:        %s\n", master.String())
-	fmt.Printf("xpub:        %s\n", pub.String())
+	fmt.Printf("%-13s%s\n", net.PrivateKeyHRP+":", master.String())
+	fmt.Printf("%-13s%s\n", net.PublicKeyHRP+":", pub.String())
 	fmt.Println()
 	fmt.Printf("Private Key: %x\n", master.PrivateKeyBytes())
 	fmt.Printf("Public Key:  %x\n", master.PublicKeyBytes())
@@ -117,7 +122,9 @@ func cmdDerive(args []string) {
 	path := fs.String("path", "", "Derivation path (e.g., m/44'/0'/0'/0/0)")
 	index := fs.Int("index", -1, "Single child index (alternative to path)")
 	hardened := fs.Bool("hardened", false, "Use hardened derivation for --index")
+	format := fs.String("format", "text", "Output format: text or json")
 	fs.Parse(args)
+	checkFormat(*format)
 
 	if *keyStr == "" {
 		fmt.Println("Error: --key is required")
@@ -137,42 +144,57 @@ func cmdDerive(args []string) {
 		os.Exit(1)
 	}
 
-	var child *bip32.ExtendedKey
+	child, pathStr, err := deriveChild(key, *path, *index, *hardened)
+	if err != nil {
+		fmt.Printf("Error: derivation failed: %v\n", err)
+		os.Exit(1)
+	}
 
-	if *path != "" {
-		child, err = key.DeriveFromPathString(*path)
-		if err != nil {
-			fmt.Printf("Error: derivation failed: %v\n", err)
-			os.Exit(1)
-		}
-		fmt.Printf("=== Derived Key: %s ===\n", *path)
-	} else {
-		idx := uint32(*index)
-		if *hardened {
-			idx = bip32.Hardened(idx)
-		}
-		childKey, err := key.Child(idx)
-		if err != nil {
-			fmt.Printf("Error: derivation failed: %v\n", err)
-			os.Exit(1)
-		}
-		child = childKey.(*bip32.ExtendedKey)
+	if err := writeDerived(os.Stdout, child, pathStr, *format); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+}
 
-		pathStr := fmt.Sprintf("%d", *index)
-		if *hardened {
-			pathStr += "'"
-		}
-		fmt.Printf("=== Derived Key: %s ===\n", pathStr)
+// deriveChild derives the key at path, or at the single child index when
+// path is empty, returning it with the path to display
+func deriveChild(key *bip32.ExtendedKey, path string, index int, hardened bool) (*bip32.ExtendedKey, string, error) {
+	if path != "" {
+		child, err := key.DeriveFromPathString(path)
+		return child, path, err
 	}
 
-	fmt.Println()
-	printKeyInfo(child)
+	idx := uint32(index)
+	pathStr := fmt.Sprintf("%d", index)
+	if hardened {
+		idx = bip32.Hardened(idx)
+		pathStr += "'"
+	}
+	child, err := key.Child(idx)
+	if err != nil {
+		return nil, "", err
+	}
+	return child.(*bip32.ExtendedKey), pathStr, nil
+}
+
+// writeDerived writes the output of derive in the given format
+func writeDerived(w io.Writer, child *bip32.ExtendedKey, pathStr, format string) error {
+	if format == "json" {
+		return writeJSON(w, newKeyJSON(child, pathStr))
+	}
+
+	fmt.Fprintf(w, "=== Derived Key: %s ===\n", pathStr)
+	fmt.Fprintln(w)
+	writeKeyInfo(w, child)
+	return nil
 }
 
 func cmdParse(args []string) {
 	fs := flag.NewFlagSet("parse", flag.ExitOnError)
 	keyStr := fs.String("key", "", "Extended key to parse")
+	format := fs.String("format", "text", "Output format: text or json")
 	fs.Parse(args)
+	checkFormat(*format)
 
 	if *keyStr == "" {
 		fmt.Println("Error: --key is required")
@@ -186,6 +208,11 @@ func cmdParse(args []string) {
 		os.Exit(1)
 	}
 
+	if *format == "json" {
+		printJSON(newKeyJSON(key, ""))
+		return
+	}
+
 	fmt.Println("=== Extended Key Info ===")
 	fmt.Println()
 	printKeyInfo(key)
@@ -194,7 +221,9 @@ func cmdParse(args []string) {
 func cmdInfo(args []string) {
 	fs := flag.NewFlagSet("info", flag.ExitOnError)
 	keyStr := fs.String("key", "", "Extended key")
+	format := fs.String("format", "text", "Output format: text or json")
 	fs.Parse(args)
+	checkFormat(*format)
 
 	if *keyStr == "" {
 		fmt.Println("Error: --key is required")
@@ -207,65 +236,167 @@ func cmdInfo(args []string) {
 		os.Exit(1)
 	}
 
+	// Show some derived keys of a private master key
+	var derived []derivedJSON
+	if key.Depth() == 0 && key.IsPrivate() {
+		for _, p := range commonPaths(key.Network()) {
+			child, err := key.DeriveFromPathString(p.path)
+			if err != nil {
+				continue
+			}
+			derived = append(derived, derivedJSON{
+				Name:       p.name,
+				Path:       p.path,
+				PrivateKey: hex.EncodeToString(child.PrivateKeyBytes()),
+				PublicKey:  hex.EncodeToString(child.PublicKeyBytes()),
+			})
+		}
+	}
+
+	if *format == "json" {
+		printJSON(infoJSON{newKeyJSON(key, ""), derived})
+		return
+	}
+
 	fmt.Println("=== Key Details ===")
 	fmt.Println()
 	printKeyInfo(key)
 
-	// Show some derived addresses
-	if key.Depth() == 0 {
+	if len(derived) > 0 {
 		fmt.Println()
 		fmt.Println("=== Common Derivation Paths ===")
-		paths := []struct {
-			name string
-			path string
-		}{
-			{"Bitcoin (BIP-44)", "m/44'/0'/0'/0/0"},
-			{"Ethereum (BIP-44)", "m/44'/60'/0'/0/0"},
-			{"Bitcoin SegWit (BIP-84)", "m/84'/0'/0'/0/0"},
+		for _, d := range derived {
+			fmt.Printf("\n%s: %s\n", d.Name, d.Path)
+			fmt.Printf("  Private: %s\n", d.PrivateKey)
+			fmt.Printf("  Public:  %s\n", d.PublicKey)
 		}
+	}
+}
 
-		for _, p := range paths {
-			if key.IsPrivate() {
-				derived, err := key.DeriveFromPathString(p.path)
-				if err != nil {
-					continue
-				}
-				fmt.Printf("\n%s: %s\n", p.name, p.path)
-				fmt.Printf("  Private: %x\n", derived.PrivateKeyBytes())
-				fmt.Printf("  Public:  %x\n", derived.PublicKeyBytes())
-			}
-		}
+// commonPaths lists the derivation paths info shows for a master key. Bitcoin
+// paths use coin type 1 for testnet keys.
+func commonPaths(net *bip32.Network) []struct{ name, path string } {
+	btcCoin := 0
+	if net == bip32.TestNet {
+		btcCoin = 1
 	}
+	return []struct{ name, path string }{
+		{"Bitcoin (BIP-44)", fmt.Sprintf("m/44'/%d'/0'/0/0", btcCoin)},
+		{"Ethereum (BIP-44)", "m/44'/60'/0'/0/0"},
+		{"Bitcoin SegWit (BIP-84)", fmt.Sprintf("m/84'/%d'/0'/0/0", btcCoin)},
+	}
+}
+
+// keyJSON is the --format json view of an extended key
+type keyJSON struct {
+	Path              string `json:"path,omitempty"`
+	Type              string `json:"type"`
+	Network           string `json:"network"`
+	Depth             uint8  `json:"depth"`
+	ChildIndex        uint32 `json:"child_index"`
+	Fingerprint       string `json:"fingerprint"`
+	ParentFingerprint string `json:"parent_fingerprint"`
+	ExtendedPrivate   string `json:"extended_private_key,omitempty"`
+	ExtendedPublic    string `json:"extended_public_key"`
+	PrivateKey        string `json:"private_key,omitempty"`
+	PublicKey         string `json:"public_key"`
+	ChainCode         string `json:"chain_code"`
+}
+
+// derivedJSON is a key that info derives from a master key
+type derivedJSON struct {
+	Name       string `json:"name"`
+	Path       string `json:"path"`
+	PrivateKey string `json:"private_key"`
+	PublicKey  string `json:"public_key"`
+}
+
+// infoJSON is the --format json output of info
+type infoJSON struct {
+	keyJSON
+	Derived []derivedJSON `json:"derived,omitempty"`
+}
+
+func newKeyJSON(key *bip32.ExtendedKey, path string) keyJSON {
+	out := keyJSON{
+		Path:              path,
+		Type:              "public",
+		Network:           key.Network().Name,
+		Depth:             key.Depth(),
+		ChildIndex:        key.ChildIndex(),
+		Fingerprint:       hex.EncodeToString(key.Fingerprint()),
+		ParentFingerprint: hex.EncodeToString(key.ParentFingerprint()),
+		ExtendedPublic:    key.String(),
+		PublicKey:         hex.EncodeToString(key.PublicKeyBytes()),
+		ChainCode:         hex.EncodeToString(key.ChainCode()),
+	}
+	if key.IsPrivate() {
+		pub, _ := key.Neuter()
+		out.Type = "private"
+		out.ExtendedPrivate = key.String()
+		out.ExtendedPublic = pub.String()
+		out.PrivateKey = hex.EncodeToString(key.PrivateKeyBytes())
+	}
+	return out
+}
+
+// checkFormat exits unless format is a supported --format value
+func checkFormat(format string) {
+	if format != "text" && format != "json" {
+		fmt.Printf("Error: unknown format: %s (use text or json)\n", format)
+		os.Exit(1)
+	}
+}
+
+func printJSON(v interface{}) {
+	if err := writeJSON(os.Stdout, v); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func writeJSON(w io.Writer, v interface{}) error {
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(out))
+	return err
 }
 
 func printKeyInfo(key *bip32.ExtendedKey) {
+	writeKeyInfo(os.Stdout, key)
+}
+
+func writeKeyInfo(w io.Writer, key *bip32.ExtendedKey) {
 	keyType := "Private"
 	if !key.IsPrivate() {
 		keyType = "Public"
 	}
 
-	fmt.Printf("Type:        %s Extended Key\n", keyType)
-	fmt.Printf("Network:     %s\n", key.Network().Name)
-	fmt.Printf("Depth:       %d\n", key.Depth())
-	fmt.Printf("Child Index: %d", key.ChildIndex())
+	fmt.Fprintf(w, "Type:        %s Extended Key\n", keyType)
+	fmt.Fprintf(w, "Network:     %s\n", key.Network().Name)
+	fmt.Fprintf(w, "Depth:       %d\n", key.Depth())
+	fmt.Fprintf(w, "Child Index: %d", key.ChildIndex())
 	if bip32.IsHardened(key.ChildIndex()) && key.ChildIndex() != 0 {
-		fmt.Printf(" (hardened: %d')", key.ChildIndex()-bip32.HardenedKeyStart)
+		fmt.Fprintf(w, " (hardened: %d')", key.ChildIndex()-bip32.HardenedKeyStart)
 	}
-	fmt.Println()
-	fmt.Printf("Fingerprint: %x\n", key.Fingerprint())
-	fmt.Printf("Parent FP:   %x\n", key.ParentFingerprint())
-	fmt.Println()
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "Fingerprint: %x\n", key.Fingerprint())
+	fmt.Fprintf(w, "Parent FP:   %x\n", key.ParentFingerprint())
+	fmt.Fprintln(w)
 
+	net := key.Network()
 	if key.IsPrivate() {
-		fmt.Printf("xprv:        %s\n", key.String())
+		fmt.Fprintf(w, "%-13s%s\n", net.PrivateKeyHRP+":", key.String())
 		pub, _ := key.Neuter()
-		fmt.Printf("xpub:        %s\n", pub.String())
-		fmt.Println()
-		fmt.Printf("Private Key: %x\n", key.PrivateKeyBytes())
+		fmt.Fprintf(w, "%-13s%s\n", net.PublicKeyHRP+":", pub.String())
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "Private Key: %x\n", key.PrivateKeyBytes())
 	} else {
-		fmt.Printf("xpub:        %s\n", key.String())
-		fmt.Println()
+		fmt.Fprintf(w, "%-13s%s\n", net.PublicKeyHRP+":", key.String())
+		fmt.Fprintln(w)
 	}
-	fmt.Printf("Public Key:  %x\n", key.PublicKeyBytes())
-	fmt.Printf("Chain Code:  %x\n", key.ChainCode())
+	fmt.Fprintf(w, "Public Key:  %x\n", key.PublicKeyBytes())
+	fmt.Fprintf(w, "Chain Code:  %x\n", key.ChainCode())
 }
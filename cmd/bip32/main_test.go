@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/study/crypto-accounts/pkgs/bip32"
+)
+
+func TestDeriveTestnetOutput(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	master, err := bip32.NewMasterKeyWithNetwork(seed, bip32.TestNet)
+	if err != nil {
+		t.Fatalf("NewMasterKeyWithNetwork() error = %v", err)
+	}
+
+	for _, tt := range []struct {
+		path     string
+		index    int
+		hardened bool
+		wantPath string
+	}{
+		{"m/84'/1'/0'", -1, false, "m/84'/1'/0'"},
+		{"", 5, true, "5'"},
+	} {
+		child, pathStr, err := deriveChild(master, tt.path, tt.index, tt.hardened)
+		if err != nil {
+			t.Fatalf("deriveChild(%q, %d) error = %v", tt.path, tt.index, err)
+		}
+		if pathStr != tt.wantPath {
+			t.Errorf("deriveChild() path = %s, want %s", pathStr, tt.wantPath)
+		}
+
+		var text bytes.Buffer
+		if err := writeDerived(&text, child, pathStr, "text"); err != nil {
+			t.Fatalf("writeDerived(text) error = %v", err)
+		}
+		if !strings.Contains(text.String(), "tprv:        "+child.String()) || !strings.Contains(text.String(), "tpub:        tpub") {
+			t.Errorf("writeDerived(text) lacks the tprv/tpub lines:\n%s", text.String())
+		}
+		if strings.Contains(text.String(), "xprv") || strings.Contains(text.String(), "xpub") {
+			t.Errorf("writeDerived(text) prints mainnet labels for a testnet key:\n%s", text.String())
+		}
+
+		var out bytes.Buffer
+		if err := writeDerived(&out, child, pathStr, "json"); err != nil {
+			t.Fatalf("writeDerived(json) error = %v", err)
+		}
+		var got keyJSON
+		if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+			t.Fatalf("json.Unmarshal() error = %v", err)
+		}
+		if got.Path != tt.wantPath || got.Network != "testnet" || got.Type != "private" {
+			t.Errorf("writeDerived(json) = path %s, network %s, type %s", got.Path, got.Network, got.Type)
+		}
+		if !strings.HasPrefix(got.ExtendedPrivate, "tprv") || !strings.HasPrefix(got.ExtendedPublic, "tpub") {
+			t.Errorf("writeDerived(json) keys = %s, %s, want tprv and tpub", got.ExtendedPrivate, got.ExtendedPublic)
+		}
+	}
+}
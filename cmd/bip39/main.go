@@ -30,6 +30,9 @@ Examples:
   # Generate 24-word mnemonic
   bip39 generate --words 24
 
+  # Mix your own dice rolls (1-6) into the system randomness
+  bip39 generate --words 24 --dice 3615244162534...
+
   # Validate mnemonic
   bip39 validate --mnemonic "abandon abandon ... about"
 
@@ -71,19 +74,12 @@ func cmdGenerate(args []string) {
 	fs := flag.NewFlagSet("generate", flag.ExitOnError)
 	words := fs.Int("words", 12, "Number of words (12, 15, 18, 21, or 24)")
 	passphrase := fs.String("passphrase", "", "Optional passphrase for seed generation")
+	dice := fs.String("dice", "", "Dice rolls (digits 1-6) to mix with system randomness")
 	fs.Parse(args)
 
 	// Map word count to entropy bits
-	wordToBits := map[int]int{
-		12: 128,
-		15: 160,
-		18: 192,
-		21: 224,
-		24: 256,
-	}
-
-	bits, ok := wordToBits[*words]
-	if !ok {
+	bits, err := bip39.EntropyBitsForWordCount(*words)
+	if err != nil {
 		fmt.Printf("Error: invalid word count %d. Must be 12, 15, 18, 21, or 24\n", *words)
 		os.Exit(1)
 	}
@@ -94,6 +90,21 @@ func cmdGenerate(args []string) {
 		os.Exit(1)
 	}
 
+	if *dice != "" {
+		for _, c := range *dice {
+			if c < '1' || c > '6' {
+				fmt.Printf("Error: invalid dice roll %q, must be 1-6\n", c)
+				os.Exit(1)
+			}
+		}
+
+		entropy, err = bip39.MixEntropy(bits, entropy, []byte(*dice))
+		if err != nil {
+			fmt.Printf("Error: failed to mix entropy: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
 	mnemonic, err := bip39.NewMnemonic(entropy)
 	if err != nil {
 		fmt.Printf("Error: failed to generate mnemonic: %v\n", err)
@@ -105,6 +116,10 @@ func cmdGenerate(args []string) {
 	fmt.Println("=== Generated Mnemonic ===")
 	fmt.Printf("Words:      %d\n", *words)
 	fmt.Printf("Entropy:    %x\n", entropy)
+	if *dice != "" {
+		// Each d6 roll carries log2(6) ~ 2.585 bits
+		fmt.Printf("Sources:    system RNG + %d dice rolls (~%d bits)\n", len(*dice), len(*dice)*2585/1000)
+	}
 	fmt.Println()
 	fmt.Println("Mnemonic:")
 	printMnemonic(mnemonic)
@@ -151,10 +166,25 @@ func cmdValidate(args []string) {
 		if err != nil {
 			fmt.Printf("Error: %v\n", err)
 		}
+		printSuggestions(*mnemonic)
 		os.Exit(1)
 	}
 }
 
+// printSuggestions prints "did you mean" hints for words not in the word list
+func printSuggestions(mnemonic string) {
+	for i, word := range strings.Fields(mnemonic) {
+		if bip39.English.WordIndex(word) != -1 {
+			continue
+		}
+		if suggestions := bip39.SuggestWord(word); len(suggestions) > 0 {
+			fmt.Printf("Word %d %q is not in the word list, did you mean: %s\n", i+1, word, strings.Join(suggestions, ", "))
+		} else {
+			fmt.Printf("Word %d %q is not in the word list\n", i+1, word)
+		}
+	}
+}
+
 func cmdSeed(args []string) {
 	fs := flag.NewFlagSet("seed", flag.ExitOnError)
 	mnemonic := fs.String("mnemonic", "", "Mnemonic phrase")
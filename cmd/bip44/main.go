@@ -22,6 +22,7 @@ Commands:
   account     Show account information
   coins       List supported coin types
   parse       Parse and display path info
+  compare     Compare the first addresses of two mnemonics
 
 Examples:
   # Derive Bitcoin addresses from mnemonic
@@ -38,6 +39,9 @@ Examples:
 
   # Parse BIP-44 path
   bip44 parse --path "m/44'/60'/0'/0/0"
+
+  # Check a restored mnemonic against a reference
+  bip44 compare --mnemonic "abandon ... about" --mnemonic "abandon ... about" --coin eth --count 5
 `
 
 func main() {
@@ -55,6 +59,8 @@ func main() {
 		cmdCoins(os.Args[2:])
 	case "parse":
 		cmdParse(os.Args[2:])
+	case "compare":
+		cmdCompare(os.Args[2:])
 	case "help", "-h", "--help":
 		fmt.Print(usage)
 	default:
@@ -247,6 +253,68 @@ func cmdParse(args []string) {
 	fmt.Printf("Account Path:  %s\n", path.AccountPath())
 }
 
+// stringList collects the values of a repeated flag
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ", ")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+func cmdCompare(args []string) {
+	fs := flag.NewFlagSet("compare", flag.ExitOnError)
+	var mnemonics stringList
+	fs.Var(&mnemonics, "mnemonic", "Mnemonic phrase (give exactly two)")
+	passphrase := fs.String("passphrase", "", "Optional passphrase for both mnemonics")
+	coin := fs.String("coin", "btc", "Coin type (btc, eth, ltc, etc.)")
+	count := fs.Uint("count", 5, "Number of addresses to compare")
+	fs.Parse(args)
+
+	if len(mnemonics) != 2 {
+		fmt.Println("Error: --mnemonic must be given exactly twice")
+		os.Exit(1)
+	}
+
+	coinType, err := parseCoinType(*coin)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	wallets := make([]*bip44.Wallet, 2)
+	for i, m := range mnemonics {
+		wallets[i], err = bip44.NewWalletFromMnemonic(m, *passphrase)
+		if err != nil {
+			fmt.Printf("Error: mnemonic %d: %v\n", i+1, err)
+			os.Exit(1)
+		}
+	}
+
+	diffs, err := bip44.CompareWallets(wallets[0], wallets[1], coinType, uint32(*count))
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("=== Wallet Comparison ===")
+	fmt.Printf("Coin: %d, Addresses: %d\n", coinType, *count)
+	fmt.Println()
+
+	if len(diffs) == 0 {
+		fmt.Println("Match: all addresses are identical")
+		return
+	}
+
+	for _, i := range diffs {
+		fmt.Printf("Differs at: %s\n", bip44.NewPath(coinType, 0, bip44.ExternalChain, uint32(i)).String())
+	}
+	os.Exit(1)
+}
+
 func parseCoinType(coin string) (bip44.CoinType, error) {
 	coin = strings.ToLower(strings.TrimSpace(coin))
 
@@ -279,6 +347,8 @@ func parseCoinType(coin string) (bip44.CoinType, error) {
 		return bip44.CoinTypePolygon, nil
 	case "avax", "avalanche":
 		return bip44.CoinTypeAvalanche, nil
+	case "qtum":
+		return bip44.CoinTypeQtum, nil
 	case "test", "testnet":
 		return bip44.CoinTypeTestnet, nil
 	default:
@@ -4,6 +4,9 @@ go 1.24.0
 
 toolchain go1.24.11
 
-require golang.org/x/crypto v0.46.0
+require (
+	filippo.io/edwards25519 v1.1.0
+	golang.org/x/crypto v0.46.0
+)
 
 require golang.org/x/sys v0.39.0 // indirect
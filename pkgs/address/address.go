@@ -3,6 +3,8 @@ package address
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 )
 
 // Common errors
@@ -14,8 +16,40 @@ var (
 	ErrInvalidChecksum    = errors.New("invalid checksum")
 	ErrInvalidVersion     = errors.New("invalid version byte")
 	ErrInvalidKeyLength   = errors.New("invalid key length")
+	ErrAmbiguousAddress   = errors.New("address is valid on multiple chains")
+	ErrInvalidWitness     = errors.New("invalid witness program")
+	ErrNetworkMismatch    = errors.New("address is for a different network")
+	ErrUnknownKeyFormat   = errors.New("unrecognized key format")
+	ErrInvalidIndex       = errors.New("invalid derivation index")
 )
 
+// AddressError describes a key or address a chain's generator rejected. It
+// wraps a sentinel such as ErrInvalidPublicKey, so errors.Is still matches.
+type AddressError struct {
+	ChainID     ChainID
+	GotLength   int   // Length of the rejected input in bytes
+	WantLengths []int // Lengths the chain accepts
+	Err         error
+}
+
+func (e *AddressError) Error() string {
+	want := make([]string, len(e.WantLengths))
+	for i, n := range e.WantLengths {
+		want[i] = fmt.Sprint(n)
+	}
+	return fmt.Sprintf("%s: %v: got %d bytes, want %s", e.ChainID, e.Err, e.GotLength, strings.Join(want, " or "))
+}
+
+// Unwrap returns the wrapped sentinel error
+func (e *AddressError) Unwrap() error {
+	return e.Err
+}
+
+// keyLengthError reports a public key of the wrong length for a chain
+func keyLengthError(chainID ChainID, got int, want ...int) error {
+	return &AddressError{ChainID: chainID, GotLength: got, WantLengths: want, Err: ErrInvalidPublicKey}
+}
+
 // AddressType represents the type of address format
 type AddressType int
 
@@ -35,8 +69,72 @@ const (
 	AddressTypeBase32
 	AddressTypeSS58
 	AddressTypeCashAddr
+
+	// Bitcoin SegWit types, told apart by witness version and program length.
+	// AddressTypeBitcoinBech32 covers the remaining (future) witness versions.
+	AddressTypeBitcoinP2WPKH
+	AddressTypeBitcoinP2WSH
+	AddressTypeBitcoinP2TR
+
+	// Hex-encoded keys or hashes, such as Kadena k: accounts and Aptos or Sui addresses
+	AddressTypeHex
 )
 
+// addressTypeNames holds the stable string form of each AddressType
+var addressTypeNames = map[AddressType]string{
+	AddressTypeBitcoinP2PKH:  "p2pkh",
+	AddressTypeBitcoinP2SH:   "p2sh",
+	AddressTypeBitcoinBech32: "segwit",
+	AddressTypeEthereum:      "ethereum",
+	AddressTypeBech32:        "bech32",
+	AddressTypeBase58Check:   "base58check",
+	AddressTypeBase58:        "base58",
+	AddressTypeBase32:        "base32",
+	AddressTypeSS58:          "ss58",
+	AddressTypeCashAddr:      "cashaddr",
+	AddressTypeBitcoinP2WPKH: "p2wpkh",
+	AddressTypeBitcoinP2WSH:  "p2wsh",
+	AddressTypeBitcoinP2TR:   "p2tr",
+	AddressTypeHex:           "hex",
+}
+
+// String returns the stable lowercase name of the address type
+func (t AddressType) String() string {
+	if name, ok := addressTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("AddressType(%d)", int(t))
+}
+
+// MarshalText encodes the address type by name, so JSON output is readable
+func (t AddressType) MarshalText() ([]byte, error) {
+	if _, ok := addressTypeNames[t]; !ok {
+		return nil, fmt.Errorf("unknown address type %d", int(t))
+	}
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText decodes an address type from its name
+func (t *AddressType) UnmarshalText(text []byte) error {
+	parsed, err := AddressTypeFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// AddressTypeFromString parses an address type name (case-insensitive)
+func AddressTypeFromString(s string) (AddressType, error) {
+	name := strings.ToLower(strings.TrimSpace(s))
+	for t, n := range addressTypeNames {
+		if n == name {
+			return t, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown address type %q", s)
+}
+
 // ChainID represents different blockchain networks
 type ChainID string
 
@@ -67,6 +165,10 @@ const (
 	ChainArbitrum     ChainID = "arb"
 	ChainVeChain      ChainID = "vet"
 	ChainTheta        ChainID = "theta"
+	ChainBase         ChainID = "base"
+	ChainZkSync       ChainID = "zksync"
+	ChainLinea        ChainID = "linea"
+	ChainScroll       ChainID = "scroll"
 
 	// Other chains
 	ChainBinanceBEP2  ChainID = "bnb"
@@ -85,6 +187,13 @@ const (
 	ChainICP          ChainID = "icp"
 	ChainDash         ChainID = "dash"
 	ChainEthereumClassic ChainID = "etc"
+	ChainIOTA         ChainID = "iota"
+	ChainShimmer      ChainID = "smr"
+	ChainWaves        ChainID = "waves"
+	ChainCKB          ChainID = "ckb"
+	ChainKadena       ChainID = "kda"
+	ChainNeo          ChainID = "neo"
+	ChainQtum         ChainID = "qtum"
 )
 
 // AddressGenerator is the interface for generating addresses
@@ -106,4 +215,21 @@ type AddressInfo struct {
 	ChainID    ChainID
 	Type       AddressType
 	Version    byte
+	Network    Network // Set by chains whose addresses encode the network
+}
+
+// Number of characters Redact keeps at each end of an address
+const (
+	redactPrefixLen = 6
+	redactSuffixLen = 4
+)
+
+// Redact shortens an address for logs and UI display, keeping the first 6 and
+// last 4 characters, e.g. "bc1qw5...f3t4". Addresses too short to gain from
+// shortening are returned unchanged.
+func Redact(addr string) string {
+	if len(addr) <= redactPrefixLen+len("...")+redactSuffixLen {
+		return addr
+	}
+	return addr[:redactPrefixLen] + "..." + addr[len(addr)-redactSuffixLen:]
 }
@@ -1,8 +1,21 @@
 package address
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"maps"
+	"math"
+	"math/big"
+	"strings"
+	"sync"
 	"testing"
+
+	"github.com/study/crypto-accounts/pkgs/bip39"
+	"github.com/study/crypto-accounts/pkgs/crypto/ed25519"
+	"github.com/study/crypto-accounts/pkgs/crypto/rsa"
 )
 
 // Test vectors from known sources
@@ -156,6 +169,45 @@ func TestTronAddress(t *testing.T) {
 	}
 }
 
+func TestTronEthAddressConversion(t *testing.T) {
+	tron := NewTronAddress(false)
+
+	// USDT's TRC-20 contract
+	const tronAddr = "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"
+	const ethAddr = "0xa614f803B6FD780986A42c78Ec9c7f77e6DeD13C"
+
+	got, err := tron.ToEthAddress(tronAddr)
+	if err != nil {
+		t.Fatalf("ToEthAddress() error = %v", err)
+	}
+	if got != ethAddr {
+		t.Errorf("ToEthAddress() = %s, want %s", got, ethAddr)
+	}
+
+	got, err = tron.ToEthAddress("41a614f803b6fd780986a42c78ec9c7f77e6ded13c")
+	if err != nil {
+		t.Fatalf("ToEthAddress() error = %v", err)
+	}
+	if got != ethAddr {
+		t.Errorf("ToEthAddress(hex) = %s, want %s", got, ethAddr)
+	}
+
+	got, err = tron.FromEthAddress(strings.ToLower(ethAddr))
+	if err != nil {
+		t.Fatalf("FromEthAddress() error = %v", err)
+	}
+	if got != tronAddr {
+		t.Errorf("FromEthAddress() = %s, want %s", got, tronAddr)
+	}
+
+	if _, err := NewTronAddress(true).ToEthAddress(tronAddr); !errors.Is(err, ErrInvalidAddress) {
+		t.Errorf("testnet ToEthAddress() of a mainnet address error = %v, want ErrInvalidAddress", err)
+	}
+	if _, err := tron.FromEthAddress("0x1234"); err == nil {
+		t.Error("FromEthAddress() should fail for an invalid address")
+	}
+}
+
 func TestSolanaAddress(t *testing.T) {
 	sol := NewSolanaAddress()
 
@@ -210,6 +262,40 @@ func TestStellarAddress(t *testing.T) {
 	}
 }
 
+func TestStellarGetKeyType(t *testing.T) {
+	stellar := NewStellarAddress()
+
+	// SEP-23 strkey test vectors
+	tests := []struct {
+		strkey string
+		want   StellarKeyType
+	}{
+		{"GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ", StellarKeyAccount},
+		{"SBU2RRGLXH3E5CQHTD3ODLDF2BWDCYUSSBLLZ5GNW7JXHDIYKXZWHOKR", StellarKeySeed},
+		{"MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVAAAAAAAAAAAAAJLK", StellarKeyMuxed},
+		{"CA3D5KRYM6CB7OWQ6TWYRR3Z4T7GNZLKERYNZGGA5SOAOPIFY6YQGAXE", StellarKeyContract},
+	}
+
+	for _, tt := range tests {
+		got, err := stellar.GetKeyType(tt.strkey)
+		if err != nil {
+			t.Fatalf("GetKeyType(%s) error = %v", tt.strkey, err)
+		}
+		if got != tt.want {
+			t.Errorf("GetKeyType(%s) = %s, want %s", tt.strkey, got, tt.want)
+		}
+
+		// Only account IDs are addresses, even with a valid checksum
+		if valid := stellar.Validate(tt.strkey); valid != (tt.want == StellarKeyAccount) {
+			t.Errorf("Validate(%s) = %v", tt.strkey, valid)
+		}
+	}
+
+	if _, err := stellar.GetKeyType("GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGA"); err == nil {
+		t.Error("GetKeyType() should reject a bad checksum")
+	}
+}
+
 func TestRippleAddress(t *testing.T) {
 	xrp := NewRippleAddress()
 
@@ -232,6 +318,42 @@ func TestRippleAddress(t *testing.T) {
 	}
 }
 
+func TestRippleEd25519Address(t *testing.T) {
+	xrp := NewRippleAddress()
+
+	// XRP Ledger wallet_propose example for an Ed25519 key
+	pubKey, _ := hex.DecodeString("ED9434799226374926EDA3B54B1B461B4ABF7237962EAE18528FEA67595397FA32")
+
+	addr, err := xrp.Generate(pubKey)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if addr != "rDTXLQ7ZKZVKz33zJbHjgVShjsBnqMBhmN" {
+		t.Errorf("Generate() = %s, want rDTXLQ7ZKZVKz33zJbHjgVShjsBnqMBhmN", addr)
+	}
+	if !xrp.Validate(addr) {
+		t.Error("Address validation failed")
+	}
+
+	raw, err := xrp.GenerateEd25519(pubKey[1:])
+	if err != nil {
+		t.Fatalf("GenerateEd25519() error = %v", err)
+	}
+	if raw != addr {
+		t.Errorf("GenerateEd25519() = %s, want %s", raw, addr)
+	}
+
+	// The same 32 bytes read as a secp256k1 X coordinate give another account
+	secpKey := append([]byte{0x02}, pubKey[1:]...)
+	if secpAddr, err := xrp.Generate(secpKey); err != nil || secpAddr == addr {
+		t.Errorf("Generate(secp256k1) = %s, %v, want an address distinct from %s", secpAddr, err, addr)
+	}
+
+	if _, err := xrp.Generate(append([]byte{0x04}, pubKey[1:]...)); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Errorf("Generate() with prefix 0x04 error = %v, want ErrInvalidPublicKey", err)
+	}
+}
+
 func TestCosmosAddress(t *testing.T) {
 	cosmos := NewCosmosAddress()
 
@@ -274,6 +396,15 @@ func TestAlgorandAddress(t *testing.T) {
 	if !algo.Validate(addr) {
 		t.Error("Address validation failed")
 	}
+
+	// The checksum is SHA-512/256, so real mainnet addresses validate
+	zero, _ := algo.Generate(make([]byte, 32))
+	if zero != "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKQ" {
+		t.Errorf("Generate(zero key) = %s, want AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKQ", zero)
+	}
+	if feeSink := "7ZUECA7HFLZTXENRV24SHLU4AVPUTMTTDUFUBNBD64C73F3UHRTHAIOF6Q"; !algo.Validate(feeSink) {
+		t.Errorf("Validate(%s) = false, want true", feeSink)
+	}
 }
 
 func TestPolkadotAddress(t *testing.T) {
@@ -387,6 +518,45 @@ func TestNEARAddress(t *testing.T) {
 	}
 }
 
+func TestNEARAccountNames(t *testing.T) {
+	near := NewNEARAddress()
+	implicit := "98793cd91a3f870fb126f66285808c7e094afcfc4eda8a970f6648cdf0dbd6de"
+
+	tests := []struct {
+		name     string
+		named    bool
+		implicit bool
+	}{
+		{"bob.near", true, false},
+		{"a.b.c.near", true, false},
+		{"ab", true, false},
+		{"user_name-1.near", true, false},
+		{"a", false, false},
+		{strings.Repeat("a", 61) + ".near", false, false},
+		{"-bad", false, false},
+		{"bad-", false, false},
+		{"bad..name", false, false},
+		{"bad--name", false, false},
+		{"bad-_name", false, false},
+		{".near", false, false},
+		{"Bob.near", false, false},
+		{implicit, false, true},
+		{strings.ToUpper(implicit), false, false},
+	}
+
+	for _, tt := range tests {
+		if got := near.ValidateNamed(tt.name); got != tt.named {
+			t.Errorf("ValidateNamed(%q) = %v, want %v", tt.name, got, tt.named)
+		}
+		if got := near.ValidateImplicit(tt.name); got != tt.implicit {
+			t.Errorf("ValidateImplicit(%q) = %v, want %v", tt.name, got, tt.implicit)
+		}
+		if got := near.Validate(tt.name); got != (tt.named || tt.implicit) {
+			t.Errorf("Validate(%q) = %v, want %v", tt.name, got, tt.named || tt.implicit)
+		}
+	}
+}
+
 func TestCardanoAddress(t *testing.T) {
 	ada := NewCardanoAddress()
 
@@ -434,6 +604,89 @@ func TestCardanoAddress(t *testing.T) {
 	}
 }
 
+func TestCardanoPointerAddress(t *testing.T) {
+	// CIP-19 test vector: payment key addr_vk1w0l2sr2zgfm26ztc6nl9xy8ghsk5sh6ldwemlpmp9xylzy4dtf7st80zhd
+	// with the pointer (2498243, 27, 3)
+	_, paymentKey, _, err := Bech32Decode("addr_vk1w0l2sr2zgfm26ztc6nl9xy8ghsk5sh6ldwemlpmp9xylzy4dtf7st80zhd")
+	if err != nil {
+		t.Fatalf("Bech32Decode() error = %v", err)
+	}
+
+	ada := NewCardanoAddress()
+	addr, err := ada.GeneratePointerAddress(paymentKey, 2498243, 27, 3)
+	if err != nil {
+		t.Fatalf("GeneratePointerAddress() error = %v", err)
+	}
+	if want := "addr1gx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer5pnz75xxcrzqf96k"; addr != want {
+		t.Errorf("GeneratePointerAddress() = %s, want %s", addr, want)
+	}
+
+	if !ada.Validate(addr) {
+		t.Error("Pointer address validation failed")
+	}
+	addrType, err := ada.GetAddressType(addr)
+	if err != nil {
+		t.Fatalf("GetAddressType() error = %v", err)
+	}
+	if addrType != "pointer (key)" {
+		t.Errorf("GetAddressType() = %s, want pointer (key)", addrType)
+	}
+
+	testnetAddr, err := NewCardanoTestnetAddress().GeneratePointerAddress(paymentKey, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("GeneratePointerAddress() testnet error = %v", err)
+	}
+	if !NewCardanoTestnetAddress().Validate(testnetAddr) || ada.Validate(testnetAddr) {
+		t.Errorf("Testnet pointer address %s should validate only on testnet", testnetAddr)
+	}
+
+	if _, err := ada.GeneratePointerAddress(paymentKey[:31], 1, 2, 3); err == nil {
+		t.Error("GeneratePointerAddress() should reject a 31-byte key")
+	}
+}
+
+func TestCardanoAddressesFromAccountKey(t *testing.T) {
+	ada := NewCardanoAddress()
+
+	// Account extended public key: 32-byte Ed25519 public key || 32-byte chain code
+	accountKeyHex := "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a" +
+		"0101010101010101010101010101010101010101010101010101010101010101"
+	accountKey, _ := hex.DecodeString(accountKeyHex)
+
+	rewardAddr, err := ada.RewardAddressFromAccountKey(accountKey)
+	if err != nil {
+		t.Fatalf("RewardAddressFromAccountKey() error = %v", err)
+	}
+	if !strings.HasPrefix(rewardAddr, "stake1") || !ada.Validate(rewardAddr) {
+		t.Errorf("invalid reward address %s", rewardAddr)
+	}
+
+	baseAddr0, err := ada.BaseAddressFromAccountKey(accountKey, 0)
+	if err != nil {
+		t.Fatalf("BaseAddressFromAccountKey() error = %v", err)
+	}
+	baseAddr1, _ := ada.BaseAddressFromAccountKey(accountKey, 1)
+	if baseAddr0 == baseAddr1 {
+		t.Error("different payment indices should give different base addresses")
+	}
+
+	// The reward address and base addresses must share the same stake key hash
+	_, rewardData, _, _ := Bech32Decode(rewardAddr)
+	for _, addr := range []string{baseAddr0, baseAddr1} {
+		_, baseData, _, err := Bech32Decode(addr)
+		if err != nil {
+			t.Fatalf("Bech32Decode(%s) error = %v", addr, err)
+		}
+		if hex.EncodeToString(baseData[1+CardanoKeyHashSize:]) != hex.EncodeToString(rewardData[1:]) {
+			t.Errorf("stake hash mismatch between %s and %s", addr, rewardAddr)
+		}
+	}
+
+	if _, err := ada.RewardAddressFromAccountKey(accountKey[:32]); err == nil {
+		t.Error("RewardAddressFromAccountKey() should reject a 32-byte key")
+	}
+}
+
 func TestBitcoinCashAddress(t *testing.T) {
 	bch := NewBitcoinCashAddress(false)
 
@@ -457,6 +710,66 @@ func TestBitcoinCashAddress(t *testing.T) {
 	}
 }
 
+func TestCashAddrCodec(t *testing.T) {
+	hash, _ := hex.DecodeString("76a04053bda0a88bda5177b86a15c3b29f559873")
+
+	// Test vectors from the CashAddr specification
+	tests := []struct {
+		prefix  string
+		version byte
+		want    string
+	}{
+		{"bitcoincash", BCHTypeP2PKH, "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"},
+		{"bitcoincash", BCHTypeP2SH, "bitcoincash:ppm2qsznhks23z7629mms6s4cwef74vcwvn0h829pq"},
+	}
+	for _, tt := range tests {
+		got := CashAddrEncode(tt.prefix, tt.version, hash)
+		if got != tt.want {
+			t.Errorf("CashAddrEncode() = %s, want %s", got, tt.want)
+		}
+
+		prefix, version, payload, err := CashAddrDecode(tt.want)
+		if err != nil {
+			t.Fatalf("CashAddrDecode(%s) error = %v", tt.want, err)
+		}
+		if prefix != tt.prefix || version != tt.version || hex.EncodeToString(payload) != hex.EncodeToString(hash) {
+			t.Errorf("CashAddrDecode(%s) = %s, %d, %x", tt.want, prefix, version, payload)
+		}
+		if !NewBitcoinCashAddress(false).Validate(tt.want) {
+			t.Errorf("Validate(%s) = false, want true", tt.want)
+		}
+	}
+
+	// Known Kaspa Schnorr address round-trips through the shared codec
+	kaspaAddr := "kaspa:qqkqkzjvr7zwxxmjxjkmxxdwju9kjs6e9u82uh59z07vgaks6gg62v8707g73"
+	prefix, version, payload, err := CashAddrDecode(kaspaAddr)
+	if err != nil {
+		t.Fatalf("CashAddrDecode(%s) error = %v", kaspaAddr, err)
+	}
+	if got := CashAddrEncode(prefix, version, payload); got != kaspaAddr {
+		t.Errorf("CashAddrEncode() = %s, want %s", got, kaspaAddr)
+	}
+	if !NewKaspaAddress().Validate(kaspaAddr) {
+		t.Errorf("Kaspa Validate(%s) = false, want true", kaspaAddr)
+	}
+	schnorrAddr, _ := NewKaspaAddress().GenerateSchnorr(payload)
+	if schnorrAddr != kaspaAddr {
+		t.Errorf("GenerateSchnorr() = %s, want %s", schnorrAddr, kaspaAddr)
+	}
+
+	// Corrupted checksum, missing prefix and mixed case are rejected
+	invalid := []string{
+		"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6b",
+		"qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+		"bitcoincash:Qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+	}
+	for _, addr := range invalid {
+		if _, _, _, err := CashAddrDecode(addr); err == nil {
+			t.Errorf("CashAddrDecode(%s) should fail", addr)
+		}
+	}
+}
+
 func TestFactory(t *testing.T) {
 	factory := NewFactory()
 
@@ -483,75 +796,1686 @@ func TestFactory(t *testing.T) {
 	}
 }
 
-func TestBase58Encoding(t *testing.T) {
-	tests := []struct {
-		input    []byte
-		expected string
-	}{
-		{[]byte{0}, "1"},
-		{[]byte{0, 0, 0, 1}, "1112"},
-		{[]byte{97, 98, 99}, "ZiCa"},
+// infoGenerator is a minimal generator that describes its own chain
+type infoGenerator struct{ KadenaAddress }
+
+func (g *infoGenerator) ChainID() ChainID { return "custom" }
+
+func (g *infoGenerator) Info() ChainInfo {
+	return ChainInfo{ID: "custom", Name: "Custom Chain", Symbol: "CST"}
+}
+
+// pluginAddress is a stand-in for a generator registered from outside the package
+type pluginAddress struct{ *EthereumAddress }
+
+func (pluginAddress) ChainID() ChainID { return "plugin" }
+
+// restoreRegistry undoes a test's RegisterGenerator calls when it finishes
+func restoreRegistry(t *testing.T) {
+	generatorRegistry.Lock()
+	mainnet, testnet := maps.Clone(generatorRegistry.mainnet), maps.Clone(generatorRegistry.testnet)
+	generatorRegistry.Unlock()
+	defaults := DefaultFactory.snapshot()
+
+	t.Cleanup(func() {
+		generatorRegistry.Lock()
+		generatorRegistry.mainnet, generatorRegistry.testnet = mainnet, testnet
+		generatorRegistry.testnetFactory = nil
+		generatorRegistry.Unlock()
+
+		DefaultFactory.mu.Lock()
+		DefaultFactory.generators = defaults
+		DefaultFactory.mu.Unlock()
+	})
+}
+
+func TestRegisterGenerator(t *testing.T) {
+	restoreRegistry(t)
+
+	const chainID ChainID = "plugin"
+	RegisterGenerator(chainID, func() AddressGenerator { return pluginAddress{NewEthereumAddress()} })
+
+	gen, err := DefaultFactory.Get(chainID)
+	if err != nil {
+		t.Fatalf("DefaultFactory.Get() error = %v", err)
+	}
+	if gen.ChainID() != chainID {
+		t.Errorf("DefaultFactory.Get() chain = %s, want %s", gen.ChainID(), chainID)
+	}
+	if _, err := NewFactory().Get(chainID); err != nil {
+		t.Errorf("NewFactory().Get() error = %v", err)
+	}
+	if _, err := NewTestnetFactory().Get(chainID); err != nil {
+		t.Errorf("NewTestnetFactory().Get() error = %v", err)
 	}
+}
 
-	for _, tt := range tests {
-		result := Base58Encode(tt.input)
-		if result != tt.expected {
-			t.Errorf("Base58Encode(%v) = %s, want %s", tt.input, result, tt.expected)
-		}
+func TestRegisterGeneratorReplacesTestnet(t *testing.T) {
+	restoreRegistry(t)
 
-		// Test round-trip
-		decoded, err := Base58Decode(result)
-		if err != nil {
-			t.Errorf("Base58Decode(%s) error = %v", result, err)
+	// Build DefaultFactory's testnet counterpart before registering
+	const testnetAddr = "mipcBbFg9gMiCh81Kj8tqqdgoZub1ZJRfn"
+	if network, _ := DefaultFactory.NetworkOf(ChainBitcoin, testnetAddr); network != NetworkTestnet {
+		t.Fatalf("NetworkOf(%s) = %s, want testnet", testnetAddr, network)
+	}
+
+	// A registration for a built-in chain serves both networks
+	RegisterGenerator(ChainBitcoin, func() AddressGenerator { return NewEthereumAddress() })
+
+	const ethAddr = "0x9858EfFD232B4033E47d90003D41EC34EcaEda94"
+	for name, f := range map[string]*Factory{
+		"DefaultFactory":      DefaultFactory,
+		"counterpart":         DefaultFactory.counterpart(),
+		"NewTestnetFactory()": NewTestnetFactory(),
+	} {
+		if !f.Validate(ChainBitcoin, ethAddr) {
+			t.Errorf("%s does not use the registered generator", name)
 		}
-		if hex.EncodeToString(decoded) != hex.EncodeToString(tt.input) {
-			t.Errorf("Base58Decode round-trip failed: got %v, want %v", decoded, tt.input)
+	}
+}
+
+func TestRegisterGeneratorConcurrent(t *testing.T) {
+	restoreRegistry(t)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			RegisterGenerator("plugin", func() AddressGenerator { return pluginAddress{NewEthereumAddress()} })
+		}()
+		go func() {
+			defer wg.Done()
+			DefaultFactory.Validate(ChainEthereum, "0x9858EfFD232B4033E47d90003D41EC34EcaEda94")
+			DefaultFactory.DetectChains("0x9858EfFD232B4033E47d90003D41EC34EcaEda94")
+		}()
+	}
+	wg.Wait()
+
+	if _, err := DefaultFactory.Get("plugin"); err != nil {
+		t.Errorf("DefaultFactory.Get(plugin) error = %v", err)
+	}
+}
+
+// countingAddress counts the Validate calls reaching a generator
+type countingAddress struct {
+	AddressGenerator
+	calls int
+}
+
+func (c *countingAddress) Validate(address string) bool {
+	c.calls++
+	return c.AddressGenerator.Validate(address)
+}
+
+func TestFactoryWithCache(t *testing.T) {
+	counter := &countingAddress{AddressGenerator: NewEthereumAddress()}
+	factory := NewFactory().WithCache(2)
+	factory.Register(ChainEthereum, counter)
+
+	const (
+		addr1 = "0x9858EfFD232B4033E47d90003D41EC34EcaEda94"
+		addr2 = "0x6Fac4D18c912343BF86fa7049364Dd4E424Ab9C0"
+		addr3 = "0x0000000000000000000000000000000000000000"
+	)
+
+	for i := 0; i < 3; i++ {
+		if !factory.Validate(ChainEthereum, addr1) {
+			t.Fatalf("Validate(%s) = false, want true", addr1)
 		}
 	}
+	if factory.Validate(ChainEthereum, "0x1234") || factory.Validate(ChainEthereum, "0x1234") {
+		t.Error("Validate(0x1234) = true, want false")
+	}
+	if counter.calls != 2 {
+		t.Errorf("generator Validate calls = %d, want 2 with cache hits", counter.calls)
+	}
+
+	// addr1 was used more recently than 0x1234, so adding a third address evicts 0x1234
+	factory.Validate(ChainEthereum, addr1)
+	factory.Validate(ChainEthereum, addr2)
+	calls := counter.calls
+	factory.Validate(ChainEthereum, addr1)
+	if counter.calls != calls {
+		t.Errorf("Validate(%s) reached the generator after eviction of another address", addr1)
+	}
+	factory.Validate(ChainEthereum, "0x1234")
+	if counter.calls != calls+1 {
+		t.Errorf("Validate(0x1234) calls = %d, want %d after eviction", counter.calls, calls+1)
+	}
+
+	// A size below 1 disables caching, and registering clears the cache
+	if !NewFactory().WithCache(0).Validate(ChainEthereum, addr3) {
+		t.Errorf("uncached Validate(%s) = false, want true", addr3)
+	}
+	factory.Register(ChainEthereum, counter)
+	calls = counter.calls
+	factory.Validate(ChainEthereum, addr1)
+	if counter.calls != calls+1 {
+		t.Error("Register() did not clear the validation cache")
+	}
+
+	// Inputs longer than any address are never cached
+	long := strings.Repeat("0", maxCachedAddressLen+1)
+	calls = counter.calls
+	factory.Validate(ChainEthereum, long)
+	factory.Validate(ChainEthereum, long)
+	if counter.calls != calls+2 {
+		t.Errorf("Validate(long input) calls = %d, want %d without caching", counter.calls-calls, 2)
+	}
 }
 
-func TestBech32Encoding(t *testing.T) {
-	// Test vector from BIP-173
-	hrp := "bc"
-	data := []byte{0x75, 0x1e, 0x76, 0xe8, 0x19, 0x91, 0x96, 0xd4, 0x54, 0x94, 0x1c, 0x45, 0xd1, 0xb3, 0xa3, 0x23, 0xf1, 0x43, 0x3b, 0xd6}
+// fixedAddress is a generator whose Validate always returns valid
+type fixedAddress struct {
+	*EthereumAddress
+	valid bool
+}
 
-	// Encode
-	encoded, err := SegWitEncode(hrp, 0, data)
-	if err != nil {
-		t.Fatalf("SegWitEncode() error = %v", err)
+func (f fixedAddress) Validate(string) bool { return f.valid }
+
+func TestFactoryWithCacheConcurrent(t *testing.T) {
+	const addr = "0x9858EfFD232B4033E47d90003D41EC34EcaEda94"
+	factory := NewFactory().WithCache(16)
+
+	for round := 0; round < 50; round++ {
+		factory.Register(ChainEthereum, fixedAddress{NewEthereumAddress(), true})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 20; j++ {
+					factory.Validate(ChainEthereum, addr)
+					factory.Validate(ChainBitcoin, "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH")
+				}
+			}()
+		}
+
+		// A result computed by the replaced generator must not outlive Register
+		factory.Register(ChainEthereum, fixedAddress{NewEthereumAddress(), false})
+		wg.Wait()
+
+		if factory.Validate(ChainEthereum, addr) {
+			t.Fatalf("round %d: Validate() returned the replaced generator's cached result", round)
+		}
 	}
+}
 
-	// Should start with bc1q (version 0)
-	if encoded[:4] != "bc1q" {
-		t.Errorf("Encoded address should start with bc1q, got %s", encoded[:4])
+// blockingAddress is a generator whose Validate waits for release
+type blockingAddress struct {
+	*EthereumAddress
+	entered, release chan struct{}
+}
+
+func (b blockingAddress) Validate(string) bool {
+	close(b.entered)
+	<-b.release
+	return true
+}
+
+func TestFactoryWithCacheRegisterDuringValidate(t *testing.T) {
+	const addr = "0x9858EfFD232B4033E47d90003D41EC34EcaEda94"
+	factory := NewFactory().WithCache(16)
+	slow := blockingAddress{NewEthereumAddress(), make(chan struct{}), make(chan struct{})}
+	factory.Register(ChainEthereum, slow)
+
+	done := make(chan bool)
+	go func() { done <- factory.Validate(ChainEthereum, addr) }()
+
+	// Replace the generator while it is validating
+	<-slow.entered
+	factory.Register(ChainEthereum, fixedAddress{NewEthereumAddress(), false})
+	close(slow.release)
+	if !<-done {
+		t.Fatal("Validate() = false, want the slow generator's true")
 	}
 
-	// Known BIP-173 test vector address
-	// For this pubkeyhash, the expected address is bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4
-	expectedAddr := "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"
-	if encoded != expectedAddr {
-		t.Errorf("Encoded address = %s, want %s", encoded, expectedAddr)
+	if factory.Validate(ChainEthereum, addr) {
+		t.Error("Validate() returned the replaced generator's result after Register")
 	}
 }
 
-func TestHash160(t *testing.T) {
-	// Test vector
-	input, _ := hex.DecodeString("0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798")
-	expected := "751e76e8199196d454941c45d1b3a323f1433bd6"
+func TestPublicKeyHash(t *testing.T) {
+	pubKey, _ := hex.DecodeString("0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798")
 
-	result := Hash160(input)
-	if hex.EncodeToString(result) != expected {
-		t.Errorf("Hash160() = %s, want %s", hex.EncodeToString(result), expected)
+	tests := []struct {
+		chainID ChainID
+		address string
+		want    string
+	}{
+		{ChainBitcoin, "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", hex.EncodeToString(Hash160(pubKey))},
+		{ChainEthereum, "0x9858EfFD232B4033E47d90003D41EC34EcaEda94", "9858effd232b4033e47d90003d41ec34ecaeda94"},
+	}
+
+	for _, tt := range tests {
+		got, err := PublicKeyHash(tt.chainID, tt.address)
+		if err != nil {
+			t.Fatalf("PublicKeyHash(%s, %s) error = %v", tt.chainID, tt.address, err)
+		}
+		if hex.EncodeToString(got) != tt.want {
+			t.Errorf("PublicKeyHash(%s, %s) = %x, want %s", tt.chainID, tt.address, got, tt.want)
+		}
+	}
+
+	if _, err := PublicKeyHash(ChainEthereum, "0x1234"); err == nil {
+		t.Error("PublicKeyHash() should fail for an invalid address")
+	}
+	if _, err := PublicKeyHash("unknown", "0x1234"); !errors.Is(err, ErrUnsupportedChain) {
+		t.Errorf("PublicKeyHash() on an unknown chain error = %v, want ErrUnsupportedChain", err)
 	}
 }
 
-func TestKeccak256(t *testing.T) {
-	// Test vector: Keccak256("") = c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470
-	input := []byte{}
-	expected := "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"
+func TestImportKey(t *testing.T) {
+	const g = "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
 
-	result := Keccak256(input)
-	if hex.EncodeToString(result) != expected {
+	tests := []struct {
+		name       string
+		input      string
+		format     KeyFormat
+		pubKey     string
+		compressed bool
+		testnet    bool
+	}{
+		{"hex", "0x0000000000000000000000000000000000000000000000000000000000000001", KeyFormatHex, g, true, false},
+		{"compressed WIF", "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn", KeyFormatWIF, g, true, false},
+		{"uncompressed WIF", "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreAnchuDf", KeyFormatWIF,
+			"0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8", false, false},
+		{"testnet WIF", "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA", KeyFormatWIF, g, true, true},
+		// BIP-32 test vector 1 master key
+		{"xprv", "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi", KeyFormatExtendedKey,
+			"0339a36013301597daef41fbe593a02cc513d0b55527ec2df1050e2e8ff49c85c2", true, false},
+		{"xpub", "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8", KeyFormatExtendedKey,
+			"0339a36013301597daef41fbe593a02cc513d0b55527ec2df1050e2e8ff49c85c2", true, false},
+	}
+
+	for _, tt := range tests {
+		key, err := ImportKey(tt.input)
+		if err != nil {
+			t.Fatalf("ImportKey(%s) error = %v", tt.name, err)
+		}
+		if key.Format != tt.format || key.Curve != CurveSecp256k1 {
+			t.Errorf("ImportKey(%s) = %s on %s, want %s on secp256k1", tt.name, key.Format, key.Curve, tt.format)
+		}
+		if hex.EncodeToString(key.PublicKey) != tt.pubKey {
+			t.Errorf("ImportKey(%s) public key = %x, want %s", tt.name, key.PublicKey, tt.pubKey)
+		}
+		if key.Compressed != tt.compressed || key.Testnet != tt.testnet {
+			t.Errorf("ImportKey(%s) compressed, testnet = %v, %v, want %v, %v", tt.name, key.Compressed, key.Testnet, tt.compressed, tt.testnet)
+		}
+	}
+
+	if key, _ := ImportKey("xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8"); key.PrivateKey != nil {
+		t.Error("ImportKey(xpub) returned a private key")
+	}
+
+	mnemonic := "  abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about\n"
+	key, err := ImportKey(mnemonic)
+	if err != nil {
+		t.Fatalf("ImportKey(mnemonic) error = %v", err)
+	}
+	wantMaster := "xprv9s21ZrQH143K3GJpoapnV8SFfukcVBSfeCficPSGfubmSFDxo1kuHnLisriDvSnRRuL2Qrg5ggqHKNVpxR86QEC8w35uxmGoggxtQTPvfUu"
+	if key.Format != KeyFormatMnemonic || key.ExtendedKey.String() != wantMaster {
+		t.Errorf("ImportKey(mnemonic) = %s, %s, want mnemonic, %s", key.Format, key.ExtendedKey, wantMaster)
+	}
+
+	rsaKey, err := rsa.GenerateKey(rsa.KeySize2048)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey() error = %v", err)
+	}
+	jwk, _ := rsa.PrivateKeyToJWK(rsaKey).ToJSON()
+	key, err = ImportKey(jwk)
+	if err != nil {
+		t.Fatalf("ImportKey(jwk) error = %v", err)
+	}
+	if key.Format != KeyFormatJWK || key.Curve != "" || !bytes.Equal(key.PublicKey, rsaKey.N.Bytes()) {
+		t.Errorf("ImportKey(jwk) = %s on %q, want jwk with the RSA modulus", key.Format, key.Curve)
+	}
+
+	if _, err := ImportKey("not a key at all"); !errors.Is(err, bip39.ErrInvalidMnemonicLength) {
+		t.Errorf("ImportKey() of a short phrase error = %v, want ErrInvalidMnemonicLength", err)
+	}
+	if _, err := ImportKey("hello"); !errors.Is(err, ErrUnknownKeyFormat) {
+		t.Errorf("ImportKey() error = %v, want ErrUnknownKeyFormat", err)
+	}
+	if _, err := ImportKey(strings.Repeat("0", 64)); !errors.Is(err, ErrInvalidPrivateKey) {
+		t.Errorf("ImportKey() of a zero key error = %v, want ErrInvalidPrivateKey", err)
+	}
+}
+
+func TestListAllChainInfoCoversFactory(t *testing.T) {
+	infos := ListAllChainInfo()
+
+	listed := make(map[ChainID]bool)
+	for _, info := range infos {
+		if info.Name == "" || info.Symbol == "" {
+			t.Errorf("chain %s has incomplete info: %+v", info.ID, info)
+		}
+		listed[info.ID] = true
+	}
+
+	for _, chainID := range DefaultFactory.ListSupportedChains() {
+		if !listed[chainID] {
+			t.Errorf("registered chain %s missing from ListAllChainInfo()", chainID)
+		}
+		if GetChainInfo(chainID) == nil {
+			t.Errorf("GetChainInfo(%s) = nil", chainID)
+		}
+	}
+
+	// Every built-in generator describes its own chain, on both networks
+	for _, f := range []*Factory{DefaultFactory, NewTestnetFactory()} {
+		for _, chainID := range f.ListSupportedChains() {
+			gen, _ := f.Get(chainID)
+			provider, ok := gen.(ChainInfoProvider)
+			if !ok {
+				t.Errorf("%s generator does not implement ChainInfoProvider", chainID)
+				continue
+			}
+			if info := provider.Info(); info.ID != chainID || info.AddressType == "" || info.Description == "" {
+				t.Errorf("%s Info() = %+v", chainID, info)
+			}
+		}
+	}
+
+	testnetIOTA, _ := NewTestnetFactory().Info(ChainIOTA)
+	if testnetIOTA.Description != "Starts with 'atoi1'" {
+		t.Errorf("testnet IOTA description = %q, want Starts with 'atoi1'", testnetIOTA.Description)
+	}
+
+	// Generators that implement ChainInfoProvider describe themselves
+	factory := NewFactory()
+	factory.Register("custom", &infoGenerator{})
+	info, err := factory.Info("custom")
+	if err != nil {
+		t.Fatalf("Info(custom) error = %v", err)
+	}
+	if info.Name != "Custom Chain" {
+		t.Errorf("Info(custom).Name = %s, want Custom Chain", info.Name)
+	}
+	if len(factory.ListChainInfo()) != len(infos)+1 {
+		t.Errorf("ListChainInfo() = %d entries, want %d", len(factory.ListChainInfo()), len(infos)+1)
+	}
+
+	if _, err := factory.Info("unsupported"); err == nil {
+		t.Error("Info() should fail for an unregistered chain")
+	}
+}
+
+func TestTestnetFactoryNetworkOf(t *testing.T) {
+	mainnet := NewFactory()
+	testnet := NewTestnetFactory()
+
+	if mainnet.Network() != NetworkMainnet || testnet.Network() != NetworkTestnet {
+		t.Fatalf("Network() = %s/%s, want mainnet/testnet", mainnet.Network(), testnet.Network())
+	}
+
+	secpKey, _ := hex.DecodeString("0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798")
+	edKey, _ := hex.DecodeString("a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed")
+
+	tests := []struct {
+		chainID ChainID
+		pubKey  []byte
+	}{
+		{ChainBitcoin, secpKey},
+		{ChainLitecoin, secpKey},
+		{ChainDogecoin, secpKey},
+		{ChainCardano, edKey},
+		{ChainKaspa, secpKey},
+	}
+
+	for _, tt := range tests {
+		mainAddr, err := mainnet.Generate(tt.chainID, tt.pubKey)
+		if err != nil {
+			t.Fatalf("mainnet Generate(%s) error = %v", tt.chainID, err)
+		}
+		testAddr, err := testnet.Generate(tt.chainID, tt.pubKey)
+		if err != nil {
+			t.Fatalf("testnet Generate(%s) error = %v", tt.chainID, err)
+		}
+		if mainAddr == testAddr {
+			t.Errorf("%s: mainnet and testnet addresses should differ", tt.chainID)
+		}
+
+		// Cross-network addresses are invalid but their network is detected
+		if mainnet.Validate(tt.chainID, testAddr) {
+			t.Errorf("%s: mainnet factory accepted testnet address %s", tt.chainID, testAddr)
+		}
+		if testnet.Validate(tt.chainID, mainAddr) {
+			t.Errorf("%s: testnet factory accepted mainnet address %s", tt.chainID, mainAddr)
+		}
+
+		if network, err := mainnet.NetworkOf(tt.chainID, testAddr); err != nil || network != NetworkTestnet {
+			t.Errorf("%s: mainnet NetworkOf(testnet addr) = %s, %v", tt.chainID, network, err)
+		}
+		if network, err := testnet.NetworkOf(tt.chainID, mainAddr); err != nil || network != NetworkMainnet {
+			t.Errorf("%s: testnet NetworkOf(mainnet addr) = %s, %v", tt.chainID, network, err)
+		}
+		if network, err := mainnet.NetworkOf(tt.chainID, mainAddr); err != nil || network != NetworkMainnet {
+			t.Errorf("%s: mainnet NetworkOf(mainnet addr) = %s, %v", tt.chainID, network, err)
+		}
+	}
+
+	// SegWit (bech32) and Taproot (bech32m) addresses carry their network in the HRP
+	segwit := []struct {
+		chainID ChainID
+		addr    string
+		network Network
+	}{
+		{ChainBitcoin, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", NetworkMainnet},
+		{ChainBitcoin, "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", NetworkTestnet},
+		{ChainBitcoin, "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", NetworkMainnet},
+		{ChainBitcoin, "tb1pqqqqp399et2xygdj5xreqhjjvcmzhxw4aywxecjdzew6hylgvsesf3hn0c", NetworkTestnet},
+		{ChainLitecoin, "ltc1qw508d6qejxtdg4y5r3zarvary0c5xw7kgmn4n9", NetworkMainnet},
+		{ChainLitecoin, "tltc1qw508d6qejxtdg4y5r3zarvary0c5xw7klfsuq0", NetworkTestnet},
+		{ChainLitecoin, "ltc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqpj6zg2", NetworkMainnet},
+		{ChainLitecoin, "tltc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vq2a7uhl", NetworkTestnet},
+	}
+	for _, tt := range segwit {
+		if got := mainnet.Validate(tt.chainID, tt.addr); got != (tt.network == NetworkMainnet) {
+			t.Errorf("mainnet Validate(%s, %s) = %v", tt.chainID, tt.addr, got)
+		}
+		if got := testnet.Validate(tt.chainID, tt.addr); got != (tt.network == NetworkTestnet) {
+			t.Errorf("testnet Validate(%s, %s) = %v", tt.chainID, tt.addr, got)
+		}
+		for _, f := range []*Factory{mainnet, testnet} {
+			if network, err := f.NetworkOf(tt.chainID, tt.addr); err != nil || network != tt.network {
+				t.Errorf("%s NetworkOf(%s, %s) = %s, %v, want %s", f.Network(), tt.chainID, tt.addr, network, err, tt.network)
+			}
+		}
+	}
+
+	// Chains without network-specific formats report the factory's own network
+	ethAddr := "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	if network, _ := testnet.NetworkOf(ChainEthereum, ethAddr); network != NetworkTestnet {
+		t.Errorf("testnet NetworkOf(eth) = %s, want testnet", network)
+	}
+
+	if _, err := mainnet.NetworkOf(ChainBitcoin, "invalid"); err != ErrInvalidAddress {
+		t.Errorf("NetworkOf(invalid) error = %v, want %v", err, ErrInvalidAddress)
+	}
+	if _, err := mainnet.NetworkOf("unsupported", "invalid"); err == nil {
+		t.Error("NetworkOf() should fail for unsupported chain")
+	}
+}
+
+func TestBase58Encoding(t *testing.T) {
+	tests := []struct {
+		input    []byte
+		expected string
+	}{
+		{[]byte{0}, "1"},
+		{[]byte{0, 0, 0, 1}, "1112"},
+		{[]byte{97, 98, 99}, "ZiCa"},
+	}
+
+	for _, tt := range tests {
+		result := Base58Encode(tt.input)
+		if result != tt.expected {
+			t.Errorf("Base58Encode(%v) = %s, want %s", tt.input, result, tt.expected)
+		}
+
+		// Test round-trip
+		decoded, err := Base58Decode(result)
+		if err != nil {
+			t.Errorf("Base58Decode(%s) error = %v", result, err)
+		}
+		if hex.EncodeToString(decoded) != hex.EncodeToString(tt.input) {
+			t.Errorf("Base58Decode round-trip failed: got %v, want %v", decoded, tt.input)
+		}
+	}
+}
+
+func TestBech32Encoding(t *testing.T) {
+	// Test vector from BIP-173
+	hrp := "bc"
+	data := []byte{0x75, 0x1e, 0x76, 0xe8, 0x19, 0x91, 0x96, 0xd4, 0x54, 0x94, 0x1c, 0x45, 0xd1, 0xb3, 0xa3, 0x23, 0xf1, 0x43, 0x3b, 0xd6}
+
+	// Encode
+	encoded, err := SegWitEncode(hrp, 0, data)
+	if err != nil {
+		t.Fatalf("SegWitEncode() error = %v", err)
+	}
+
+	// Should start with bc1q (version 0)
+	if encoded[:4] != "bc1q" {
+		t.Errorf("Encoded address should start with bc1q, got %s", encoded[:4])
+	}
+
+	// Known BIP-173 test vector address
+	// For this pubkeyhash, the expected address is bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4
+	expectedAddr := "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"
+	if encoded != expectedAddr {
+		t.Errorf("Encoded address = %s, want %s", encoded, expectedAddr)
+	}
+}
+
+func TestHash160(t *testing.T) {
+	// Test vector
+	input, _ := hex.DecodeString("0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798")
+	expected := "751e76e8199196d454941c45d1b3a323f1433bd6"
+
+	result := Hash160(input)
+	if hex.EncodeToString(result) != expected {
+		t.Errorf("Hash160() = %s, want %s", hex.EncodeToString(result), expected)
+	}
+}
+
+func TestKeccak256(t *testing.T) {
+	// Test vector: Keccak256("") = c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470
+	input := []byte{}
+	expected := "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"
+
+	result := Keccak256(input)
+	if hex.EncodeToString(result) != expected {
 		t.Errorf("Keccak256() = %s, want %s", hex.EncodeToString(result), expected)
 	}
 }
+
+func TestEd25519DerivationScheme(t *testing.T) {
+	tests := []struct {
+		chain    ChainID
+		mnemonic string
+		account  uint32
+		index    uint32
+		path     string
+		want     string
+	}{
+		// Phantom/Solflare account 0
+		{ChainSolana, "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", 0, 0,
+			"m/44'/501'/0'/0'", "HAgk14JpMQLgt6rVgv7cBQFJWFto5Dqxi472uT3DKpqk"},
+		// SEP-0005 test vector 1
+		{ChainStellar, "illness spike retreat truth genius clock brain pass fit cave bargain toe", 0, 0,
+			"m/44'/148'/0'", "GDRXE2BQUC3AZNPVFSCEZ76NJ3WWL25FYFK6RGZGIEKWE4SOOHSUJUJ6"},
+		{ChainStellar, "illness spike retreat truth genius clock brain pass fit cave bargain toe", 1, 0,
+			"m/44'/148'/1'", "GBAW5XGWORWVFE2XTJYDTLDHXTY2Q2MO73HYCGB3XMFMQ562Q2W2GJQX"},
+	}
+
+	for _, tt := range tests {
+		scheme, err := Ed25519DerivationScheme(tt.chain)
+		if err != nil {
+			t.Fatalf("Ed25519DerivationScheme(%s) error = %v", tt.chain, err)
+		}
+
+		if got, err := scheme.PathString(tt.account, tt.index); err != nil || got != tt.path {
+			t.Errorf("PathString() = %s, %v, want %s", got, err, tt.path)
+		}
+
+		_, pub, err := scheme.DeriveKey(bip39.NewSeed(tt.mnemonic, ""), tt.account, tt.index)
+		if err != nil {
+			t.Fatalf("DeriveKey() error = %v", err)
+		}
+
+		addr, err := Generate(tt.chain, pub)
+		if err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+		if addr != tt.want {
+			t.Errorf("%s address = %s, want %s", tt.chain, addr, tt.want)
+		}
+	}
+
+	scheme, _ := Ed25519DerivationScheme(ChainSui)
+	if got, _ := scheme.PathString(2, 5); got != "m/44'/784'/2'/0'/5'" {
+		t.Errorf("PathString() = %s, want m/44'/784'/2'/0'/5'", got)
+	}
+	if _, err := scheme.Path(0, ed25519.HardenedOffset); !errors.Is(err, ErrInvalidIndex) {
+		t.Errorf("Path(0, 2^31) error = %v, want ErrInvalidIndex", err)
+	}
+
+	// Account-only schemes have no index level, so (1, 0) and (0, 1) must not
+	// share a path
+	solana, _ := Ed25519DerivationScheme(ChainSolana)
+	if got, _ := solana.PathString(1, 0); got != "m/44'/501'/1'/0'" {
+		t.Errorf("PathString(1, 0) = %s, want m/44'/501'/1'/0'", got)
+	}
+	if _, err := solana.Path(0, 1); !errors.Is(err, ErrInvalidIndex) {
+		t.Errorf("Path(0, 1) error = %v, want ErrInvalidIndex", err)
+	}
+	if _, err := solana.Path(ed25519.HardenedOffset, 0); !errors.Is(err, ErrInvalidIndex) {
+		t.Errorf("Path(2^31, 0) error = %v, want ErrInvalidIndex", err)
+	}
+	if _, err := DerivationPath(ChainStellar, "", 0, 1); !errors.Is(err, ErrInvalidIndex) {
+		t.Errorf("DerivationPath(xlm, 0, 1) error = %v, want ErrInvalidIndex", err)
+	}
+
+	if _, err := Ed25519DerivationScheme(ChainEthereum); err == nil {
+		t.Error("Ed25519DerivationScheme(eth) should fail")
+	}
+
+	// Cardano wallets derive by CIP-1852 BIP32-Ed25519, not SLIP-10
+	if _, err := Ed25519DerivationScheme(ChainCardano); !errors.Is(err, ErrUnsupportedChain) {
+		t.Errorf("Ed25519DerivationScheme(ada) error = %v, want ErrUnsupportedChain", err)
+	}
+	if _, err := DefaultPath(ChainCardano, ""); !errors.Is(err, ErrUnsupportedChain) {
+		t.Errorf("DefaultPath(ada) error = %v, want ErrUnsupportedChain", err)
+	}
+}
+
+func TestNewBase58EncoderInvalidAlphabet(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("NewBase58Encoder() should panic for a repeated character")
+		}
+	}()
+	NewBase58Encoder("1" + BitcoinAlphabet[:57])
+}
+
+func TestHRPOf(t *testing.T) {
+	secpKey, _ := hex.DecodeString("0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798")
+	cosmosAddr, _ := NewCosmosAddress().Generate(secpKey)
+	cardanoAddr, _ := NewCardanoAddress().Generate(make([]byte, 32))
+
+	tests := []struct {
+		addr string
+		hrp  string
+		ok   bool
+	}{
+		{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", "bc", true},
+		{"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", "bc", true},
+		{cosmosAddr, "cosmos", true},
+		{cardanoAddr, "addr", true},
+		{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", "", false},
+		{"3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", "", false},
+		{"0x9858EfFD232B4033E47d90003D41EC34EcaEda94", "", false},
+	}
+
+	for _, tt := range tests {
+		hrp, ok := HRPOf(tt.addr)
+		if hrp != tt.hrp || ok != tt.ok {
+			t.Errorf("HRPOf(%s) = (%q, %v), want (%q, %v)", tt.addr, hrp, ok, tt.hrp, tt.ok)
+		}
+	}
+}
+
+func TestDetectChain(t *testing.T) {
+	pubKey, _ := hex.DecodeString("0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798")
+	cosmosAddr, _ := NewCosmosAddress().Generate(pubKey)
+
+	chain, err := DetectChain(cosmosAddr)
+	if err != nil {
+		t.Fatalf("DetectChain() error = %v", err)
+	}
+	if chain != ChainCosmos {
+		t.Errorf("DetectChain(%s) = %s, want %s", cosmosAddr, chain, ChainCosmos)
+	}
+
+	chain, err = DetectChain("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2")
+	if err != nil {
+		t.Fatalf("DetectChain() error = %v", err)
+	}
+	if chain != ChainBitcoin {
+		t.Errorf("DetectChain(1BvBMS...) = %s, want %s", chain, ChainBitcoin)
+	}
+
+	if _, err := DetectChain("0x9858EfFD232B4033E47d90003D41EC34EcaEda94"); !errors.Is(err, ErrAmbiguousAddress) {
+		t.Errorf("DetectChain(0x...) error = %v, want ErrAmbiguousAddress", err)
+	}
+
+	if _, err := DetectChain("not an address"); !errors.Is(err, ErrInvalidAddress) {
+		t.Errorf("DetectChain(garbage) error = %v, want ErrInvalidAddress", err)
+	}
+}
+
+func TestEIP155V(t *testing.T) {
+	tests := []struct {
+		chainID uint64
+		recID   byte
+		v       uint64
+	}{
+		{1, 0, 37},
+		{1, 1, 38},
+		{137, 0, 309},
+		{137, 1, 310},
+	}
+
+	for _, tt := range tests {
+		if got, err := EIP155V(tt.recID, tt.chainID); err != nil || got != tt.v {
+			t.Errorf("EIP155V(%d, %d) = %d, %v, want %d", tt.recID, tt.chainID, got, err, tt.v)
+		}
+
+		recID, err := SplitEIP155V(tt.v, tt.chainID)
+		if err != nil {
+			t.Fatalf("SplitEIP155V() error = %v", err)
+		}
+		if recID != tt.recID {
+			t.Errorf("SplitEIP155V(%d, %d) = %d, want %d", tt.v, tt.chainID, recID, tt.recID)
+		}
+	}
+
+	// A mainnet signature must not verify as Polygon
+	if _, err := SplitEIP155V(37, 137); !errors.Is(err, ErrInvalidRecoveryID) {
+		t.Errorf("SplitEIP155V(37, 137) error = %v, want ErrInvalidRecoveryID", err)
+	}
+
+	v, err := NewEVMAddress(ChainPolygon).EIP155V(1)
+	if err != nil || v != 310 {
+		t.Errorf("EIP155V() for Polygon = %d, %v, want 310", v, err)
+	}
+
+	// Only recovery ids 0 and 1 have an EIP-155 encoding, and V must not overflow
+	for _, recID := range []byte{2, 3, 27} {
+		if _, err := EIP155V(recID, 1); !errors.Is(err, ErrInvalidRecoveryID) {
+			t.Errorf("EIP155V(%d, 1) error = %v, want ErrInvalidRecoveryID", recID, err)
+		}
+	}
+	if _, err := EIP155V(1, math.MaxUint64/2); !errors.Is(err, ErrInvalidRecoveryID) {
+		t.Errorf("EIP155V(1, 2^63) error = %v, want ErrInvalidRecoveryID", err)
+	}
+	if v, err := EIP155V(1, maxEIP155ChainID); err != nil || v != math.MaxUint64-1 {
+		t.Errorf("EIP155V(1, max) = %d, %v, want MaxUint64-1", v, err)
+	}
+}
+
+func TestSignEIP155(t *testing.T) {
+	// The worked example of EIP-155: nonce 9, 20 gwei, 21000 gas, 1 ether to
+	// 0x3535...35 on chain 1, signed with the key 0x4646...46
+	signingData, _ := hex.DecodeString("ec098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a764000080018080")
+	hash := Keccak256(signingData)
+	if got := hex.EncodeToString(hash); got != "daf5a779ae972f972197303d7b574746c7ef83eadac0f2791ad23db92e4c8e53" {
+		t.Fatalf("signing hash = %s", got)
+	}
+	privKey := bytes.Repeat([]byte{0x46}, 32)
+
+	sig, v, err := SignEIP155(privKey, hash, 1)
+	if err != nil {
+		t.Fatalf("SignEIP155() error = %v", err)
+	}
+	wantR, _ := new(big.Int).SetString("18515461264373351373200002665853028612451056578545711640558177340181847433846", 10)
+	wantS, _ := new(big.Int).SetString("46948507304638947509940763649030358759909902576025900602547168820602576006531", 10)
+	if v != 37 || sig.R.Cmp(wantR) != 0 || sig.S.Cmp(wantS) != 0 {
+		t.Errorf("SignEIP155() = v %d, r %s, s %s, want v 37, r %s, s %s", v, sig.R, sig.S, wantR, wantS)
+	}
+
+	// The generator signs for its own chain's id
+	_, polygonV, err := NewEVMAddress(ChainPolygon).SignEIP155(privKey, hash)
+	if err != nil || polygonV != v-37+309 {
+		t.Errorf("SignEIP155() for Polygon v = %d, %v, want %d", polygonV, err, v-37+309)
+	}
+
+	if _, _, err := SignEIP155(privKey, hash, math.MaxUint64); !errors.Is(err, ErrInvalidRecoveryID) {
+		t.Errorf("SignEIP155(chain id 2^64-1) error = %v, want ErrInvalidRecoveryID", err)
+	}
+	if _, _, err := SignEIP155(privKey, hash[:31], 1); err == nil {
+		t.Error("SignEIP155() should reject a 31-byte hash")
+	}
+}
+
+func TestAddressTypeString(t *testing.T) {
+	tests := []struct {
+		typ  AddressType
+		name string
+	}{
+		{AddressTypeBitcoinP2PKH, "p2pkh"},
+		{AddressTypeBitcoinP2SH, "p2sh"},
+		{AddressTypeBitcoinBech32, "segwit"},
+		{AddressTypeEthereum, "ethereum"},
+		{AddressTypeBech32, "bech32"},
+		{AddressTypeBase58Check, "base58check"},
+		{AddressTypeBase58, "base58"},
+		{AddressTypeBase32, "base32"},
+		{AddressTypeSS58, "ss58"},
+		{AddressTypeCashAddr, "cashaddr"},
+		{AddressTypeBitcoinP2WPKH, "p2wpkh"},
+		{AddressTypeBitcoinP2WSH, "p2wsh"},
+		{AddressTypeBitcoinP2TR, "p2tr"},
+		{AddressTypeHex, "hex"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.typ.String(); got != tt.name {
+			t.Errorf("AddressType(%d).String() = %s, want %s", int(tt.typ), got, tt.name)
+		}
+
+		parsed, err := AddressTypeFromString(strings.ToUpper(tt.name))
+		if err != nil {
+			t.Fatalf("AddressTypeFromString(%s) error = %v", tt.name, err)
+		}
+		if parsed != tt.typ {
+			t.Errorf("AddressTypeFromString(%s) = %d, want %d", tt.name, parsed, tt.typ)
+		}
+	}
+
+	if got := AddressType(99).String(); got != "AddressType(99)" {
+		t.Errorf("AddressType(99).String() = %s", got)
+	}
+	if _, err := AddressTypeFromString("unknown"); err == nil {
+		t.Error("AddressTypeFromString(unknown) should fail")
+	}
+
+	data, err := json.Marshal(AddressInfo{Type: AddressTypeSS58})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"Type":"ss58"`) {
+		t.Errorf("json.Marshal() = %s, want Type encoded as ss58", data)
+	}
+
+	var info AddressInfo
+	if err := json.Unmarshal(data, &info); err != nil || info.Type != AddressTypeSS58 {
+		t.Errorf("json.Unmarshal() = %v, %v", info.Type, err)
+	}
+}
+
+func TestSolanaSignMessage(t *testing.T) {
+	sol := NewSolanaAddress()
+	privKey, _ := hex.DecodeString("9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60")
+	pubKey, _ := hex.DecodeString("d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a")
+	addr, _ := sol.Generate(pubKey)
+	msg := []byte("hello solana")
+
+	sig, err := sol.SignMessage(privKey, msg)
+	if err != nil {
+		t.Fatalf("SignMessage() error = %v", err)
+	}
+	if len(sig) != 64 {
+		t.Fatalf("SignMessage() length = %d, want 64", len(sig))
+	}
+	if !sol.VerifyMessage(addr, msg, sig) {
+		t.Error("VerifyMessage() = false for a valid signature")
+	}
+	if sol.VerifyMessage(addr, []byte("hello solanb"), sig) {
+		t.Error("VerifyMessage() = true for a different message")
+	}
+
+	encoded, err := EncodeSolanaSignature(sig)
+	if err != nil {
+		t.Fatalf("EncodeSolanaSignature() error = %v", err)
+	}
+	// 64 bytes encode to 87 or 88 Base58 characters
+	if len(encoded) < 87 || len(encoded) > 88 {
+		t.Errorf("EncodeSolanaSignature() length = %d, want 87 or 88", len(encoded))
+	}
+
+	decoded, err := DecodeSolanaSignature(encoded)
+	if err != nil {
+		t.Fatalf("DecodeSolanaSignature() error = %v", err)
+	}
+	if hex.EncodeToString(decoded) != hex.EncodeToString(sig) {
+		t.Errorf("DecodeSolanaSignature() = %x, want %x", decoded, sig)
+	}
+
+	if _, err := EncodeSolanaSignature(sig[:63]); err == nil {
+		t.Error("EncodeSolanaSignature(63 bytes) should fail")
+	}
+}
+
+func TestSolanaIsOnCurve(t *testing.T) {
+	sol := NewSolanaAddress()
+
+	// The Ed25519 base point encodes as 0x58666...66
+	basePoint, _ := hex.DecodeString("5866666666666666666666666666666666666666666666666666666666666666")
+	if !sol.IsOnCurve(Base58Encode(basePoint)) {
+		t.Error("base point address should be on-curve")
+	}
+
+	if !sol.IsOnCurve("HAgk14JpMQLgt6rVgv7cBQFJWFto5Dqxi472uT3DKpqk") {
+		t.Error("wallet address should be on-curve")
+	}
+
+	// Program-derived address: sha256(seeds || bump || program id || "ProgramDerivedAddress"),
+	// taking the first bump (counting down from 255) that lands off-curve
+	programID, _ := Base58Decode("ATokenGPvbdGVxr1b2hdZbsiqW5xWH25efTNsLJA8knL")
+	var pda string
+	for bump := 255; bump >= 0; bump-- {
+		h := sha256.New()
+		h.Write([]byte("vault"))
+		h.Write([]byte{byte(bump)})
+		h.Write(programID)
+		h.Write([]byte("ProgramDerivedAddress"))
+		candidate := Base58Encode(h.Sum(nil))
+		if !sol.IsOnCurve(candidate) {
+			pda = candidate
+			break
+		}
+	}
+
+	if pda == "" {
+		t.Fatal("no off-curve PDA found")
+	}
+	if !sol.Validate(pda) {
+		t.Errorf("PDA %s should still be a valid Solana address", pda)
+	}
+
+	if sol.IsOnCurve("invalid") {
+		t.Error("invalid address should not be on-curve")
+	}
+}
+
+func TestCardanoDecodeNetwork(t *testing.T) {
+	paymentKey := make([]byte, 32)
+	stakeKey := make([]byte, 32)
+	for i := range paymentKey {
+		paymentKey[i] = byte(i)
+		stakeKey[i] = byte(255 - i)
+	}
+
+	testnet := NewCardanoTestnetAddress()
+	addr, err := testnet.GenerateBaseAddress(paymentKey, stakeKey)
+	if err != nil {
+		t.Fatalf("GenerateBaseAddress() error = %v", err)
+	}
+	if !strings.HasPrefix(addr, "addr_test1") {
+		t.Fatalf("testnet base address = %s, want addr_test1 prefix", addr)
+	}
+
+	details, err := testnet.Decode(addr)
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if details.Network != NetworkTestnet || details.HeaderType != CardanoBaseAddress {
+		t.Errorf("Decode() network = %s, type = %d", details.Network, details.HeaderType)
+	}
+	if hex.EncodeToString(details.PaymentHash) != hex.EncodeToString(blake2b224(paymentKey)) {
+		t.Errorf("PaymentHash = %x, want %x", details.PaymentHash, blake2b224(paymentKey))
+	}
+	if hex.EncodeToString(details.StakeHash) != hex.EncodeToString(blake2b224(stakeKey)) {
+		t.Errorf("StakeHash = %x, want %x", details.StakeHash, blake2b224(stakeKey))
+	}
+
+	info, err := testnet.DecodeAddress(addr)
+	if err != nil {
+		t.Fatalf("DecodeAddress() error = %v", err)
+	}
+	if info.Network != NetworkTestnet {
+		t.Errorf("DecodeAddress().Network = %s, want %s", info.Network, NetworkTestnet)
+	}
+
+	// Reward addresses carry only the stake hash
+	mainnet := NewCardanoAddress()
+	reward, _ := mainnet.GenerateRewardAddress(stakeKey)
+	info, err = mainnet.DecodeAddress(reward)
+	if err != nil {
+		t.Fatalf("DecodeAddress() error = %v", err)
+	}
+	if info.Network != NetworkMainnet || hex.EncodeToString(info.PublicKey) != hex.EncodeToString(blake2b224(stakeKey)) {
+		t.Errorf("reward DecodeAddress() = %s, %x", info.Network, info.PublicKey)
+	}
+}
+
+func TestFromPrivateKey(t *testing.T) {
+	privKey, _ := hex.DecodeString("e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35")
+
+	addresses, err := FromPrivateKey(privKey)
+	if err != nil {
+		t.Fatalf("FromPrivateKey() error = %v", err)
+	}
+
+	for _, chain := range []ChainID{ChainBitcoin, ChainEthereum, ChainSolana} {
+		addr, ok := addresses[chain]
+		if !ok {
+			t.Errorf("FromPrivateKey() missing %s", chain)
+			continue
+		}
+		if !Validate(chain, addr) {
+			t.Errorf("FromPrivateKey()[%s] = %s is not valid", chain, addr)
+		}
+	}
+
+	// Same key material as the single-chain generators
+	if addresses[ChainBitcoin] != "15mKKb2eos1hWa6tisdPwwDC1a5J1y9nma" {
+		t.Errorf("BTC address = %s, want 15mKKb2eos1hWa6tisdPwwDC1a5J1y9nma", addresses[ChainBitcoin])
+	}
+
+	if _, ok := addresses[ChainMonero]; ok {
+		t.Error("FromPrivateKey() should skip Monero")
+	}
+
+	if _, err := FromPrivateKey(make([]byte, 32)); err == nil {
+		t.Error("FromPrivateKey(zero key) should fail")
+	}
+}
+
+func TestGenerateNaturalKeys(t *testing.T) {
+	// Compressed secp256k1 generator point and the RFC 8032 test 1 Ed25519 key
+	compressed, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	uncompressed, _ := hex.DecodeString("0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798" +
+		"483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8")
+	edKey, _ := hex.DecodeString("d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a")
+
+	for chainID, curve := range ChainCurves {
+		key := compressed
+		if curve == CurveEd25519 {
+			key = edKey
+		}
+
+		addr, err := Generate(chainID, key)
+		if err != nil {
+			t.Errorf("Generate(%s, %d-byte %s key) error = %v", chainID, len(key), curve, err)
+			continue
+		}
+		if !Validate(chainID, addr) {
+			t.Errorf("Generate(%s) = %s is not valid", chainID, addr)
+		}
+	}
+
+	// Chains that hash the uncompressed key decompress it internally
+	for _, chainID := range []ChainID{ChainEthereum, ChainTron, ChainFilecoin} {
+		fromCompressed, _ := Generate(chainID, compressed)
+		fromUncompressed, err := Generate(chainID, uncompressed)
+		if err != nil {
+			t.Fatalf("Generate(%s) error = %v", chainID, err)
+		}
+		if fromCompressed != fromUncompressed {
+			t.Errorf("Generate(%s) = %s from compressed, %s from uncompressed", chainID, fromCompressed, fromUncompressed)
+		}
+	}
+}
+
+func TestEthereumFromMnemonic(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	addr, privKey, err := EthereumFromMnemonic(mnemonic, "", 0, 0)
+	if err != nil {
+		t.Fatalf("EthereumFromMnemonic() error = %v", err)
+	}
+
+	// m/44'/60'/0'/0/0
+	if got := hex.EncodeToString(privKey); got != "1ab42cc412b618bdea3a599e3c9bae199ebf030895b039e9db1e30dafb12b727" {
+		t.Errorf("EthereumFromMnemonic() private key = %s", got)
+	}
+	if addr != "0x9858EfFD232B4033E47d90003D41EC34EcaEda94" {
+		t.Errorf("EthereumFromMnemonic() = %s, want 0x9858EfFD232B4033E47d90003D41EC34EcaEda94", addr)
+	}
+
+	next, _, err := EthereumFromMnemonic(mnemonic, "", 0, 1)
+	if err != nil {
+		t.Fatalf("EthereumFromMnemonic() error = %v", err)
+	}
+	if next != "0x6Fac4D18c912343BF86fa7049364Dd4E424Ab9C0" {
+		t.Errorf("EthereumFromMnemonic(index 1) = %s, want 0x6Fac4D18c912343BF86fa7049364Dd4E424Ab9C0", next)
+	}
+
+	if _, _, err := EthereumFromMnemonic("abandon abandon abandon", "", 0, 0); !errors.Is(err, bip39.ErrInvalidMnemonic) {
+		t.Errorf("EthereumFromMnemonic(invalid) error = %v, want %v", err, bip39.ErrInvalidMnemonic)
+	}
+}
+
+func TestAddressError(t *testing.T) {
+	tests := []struct {
+		chainID ChainID
+		want    []int
+	}{
+		{ChainSolana, []int{32}},
+		{ChainBitcoin, []int{33, 65}},
+		{ChainEthereum, []int{33, 64, 65}},
+		{ChainRipple, []int{33}},
+	}
+
+	for _, tt := range tests {
+		_, err := Generate(tt.chainID, make([]byte, 31))
+		if !errors.Is(err, ErrInvalidPublicKey) {
+			t.Errorf("Generate(%s) error = %v, want %v", tt.chainID, err, ErrInvalidPublicKey)
+			continue
+		}
+
+		var addrErr *AddressError
+		if !errors.As(err, &addrErr) {
+			t.Fatalf("Generate(%s) error = %T, want *AddressError", tt.chainID, err)
+		}
+		if addrErr.ChainID != tt.chainID || addrErr.GotLength != 31 {
+			t.Errorf("AddressError = %+v, want chain %s, length 31", addrErr, tt.chainID)
+		}
+		if len(addrErr.WantLengths) != len(tt.want) {
+			t.Errorf("AddressError.WantLengths = %v, want %v", addrErr.WantLengths, tt.want)
+			continue
+		}
+		for i := range tt.want {
+			if addrErr.WantLengths[i] != tt.want[i] {
+				t.Errorf("AddressError.WantLengths = %v, want %v", addrErr.WantLengths, tt.want)
+				break
+			}
+		}
+	}
+
+	_, err := Generate(ChainBitcoin, make([]byte, 20))
+	if got := err.Error(); got != "btc: invalid public key: got 20 bytes, want 33 or 65" {
+		t.Errorf("Error() = %q", got)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		addr string
+		want string
+	}{
+		{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", "bc1qw5...f3t4"},
+		{"0x9858EfFD232B4033E47d90003D41EC34EcaEda94", "0x9858...da94"},
+		{"0.0.12345", "0.0.12345"},
+		{"1234567890123", "1234567890123"},
+		{"12345678901234", "123456...1234"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := Redact(tt.addr); got != tt.want {
+			t.Errorf("Redact(%q) = %q, want %q", tt.addr, got, tt.want)
+		}
+	}
+}
+
+func TestEthereumValidateStrict(t *testing.T) {
+	eth := NewEthereumAddress()
+
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{"0x9858EfFD232B4033E47d90003D41EC34EcaEda94", true},  // correct checksum
+		{"0x9858EffD232B4033E47d90003D41EC34EcaEda94", false}, // one character flipped case
+		{"0x9858effd232b4033e47d90003d41ec34ecaeda94", true},  // no checksum
+		{"0x9858EFFD232B4033E47D90003D41EC34ECAEDA94", true},  // no checksum
+		{"0x9858effd232b4033e47d90003d41ec34ecaeda9", false},  // too short
+	}
+
+	for _, tt := range tests {
+		if got := eth.ValidateStrict(tt.addr); got != tt.want {
+			t.Errorf("ValidateStrict(%s) = %v, want %v", tt.addr, got, tt.want)
+		}
+	}
+
+	// Plain Validate ignores casing
+	if !eth.Validate("0x9858EffD232B4033E47d90003D41EC34EcaEda94") {
+		t.Error("Validate() should accept a bad checksum")
+	}
+}
+
+func TestCardanoHex(t *testing.T) {
+	ada := NewCardanoAddress()
+
+	// CIP-19 test vector: type-0 base address
+	bech := "addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x"
+	rawHex := "019493315cd92eb5d8c4304e67b7e16ae36d61d34502694657811a2c8e337b62cfff6403a06a3acbc34f8c46003c69fe79a3628cefa9c47251"
+
+	got, err := ada.ToHex(bech)
+	if err != nil {
+		t.Fatalf("ToHex() error = %v", err)
+	}
+	if got != rawHex {
+		t.Errorf("ToHex() = %s, want %s", got, rawHex)
+	}
+
+	back, err := ada.FromHex(rawHex)
+	if err != nil {
+		t.Fatalf("FromHex() error = %v", err)
+	}
+	if back != bech {
+		t.Errorf("FromHex() = %s, want %s", back, bech)
+	}
+
+	// Reward addresses use the stake HRP
+	stakeKey := make([]byte, 32)
+	reward, _ := ada.GenerateRewardAddress(stakeKey)
+	rewardHex, _ := ada.ToHex(reward)
+	if back, err := ada.FromHex(rewardHex); err != nil || back != reward {
+		t.Errorf("FromHex(reward) = %s, %v, want %s", back, err, reward)
+	}
+
+	// Wrong network, bad header and bad length are rejected
+	testnet := NewCardanoTestnetAddress()
+	for _, input := range []string{rawHex, "f0" + rawHex[2:], rawHex[:20], "zz"} {
+		if _, err := testnet.FromHex(input); err == nil {
+			t.Errorf("testnet FromHex(%s) should fail", input)
+		}
+	}
+}
+
+func TestCardanoRewardAddress(t *testing.T) {
+	ada := NewCardanoAddress()
+
+	// CIP-19 reward address (stake key hash)
+	const reward = "stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw"
+	if !ada.Validate(reward) || !ada.IsRewardAddress(reward) {
+		t.Errorf("IsRewardAddress(%s) = false, want true", reward)
+	}
+
+	paymentKey := make([]byte, 32)
+	stakeKey := make([]byte, 32)
+	stakeKey[0] = 1
+	base, _ := ada.GenerateBaseAddress(paymentKey, stakeKey)
+	if !ada.Validate(base) || ada.IsRewardAddress(base) {
+		t.Errorf("IsRewardAddress(%s) = true, want false", base)
+	}
+
+	// Swapping the HRPs of a base and a reward address invalidates both
+	_, baseData, _, _ := Bech32Decode(base)
+	_, rewardData, _, _ := Bech32Decode(reward)
+	for _, tt := range []struct {
+		hrp  string
+		data []byte
+	}{
+		{CardanoMainnetStakeHRP, baseData},
+		{CardanoMainnetHRP, rewardData},
+	} {
+		swapped, err := Bech32Encode(tt.hrp, tt.data, Bech32Standard)
+		if err != nil {
+			t.Fatalf("Bech32Encode() error = %v", err)
+		}
+		if ada.Validate(swapped) || ada.IsRewardAddress(swapped) {
+			t.Errorf("Validate(%s) = true, want false for a header/HRP mismatch", swapped)
+		}
+	}
+}
+
+func TestCardanoBech32mEncoding(t *testing.T) {
+	pubKey := make([]byte, 32)
+	for i := range pubKey {
+		pubKey[i] = byte(i)
+	}
+
+	standard, err := NewCardanoAddress().Generate(pubKey)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	bech32m, err := NewCardanoAddress().WithEncoding(Bech32m).Generate(pubKey)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if bech32m == standard {
+		t.Fatal("Bech32m address should differ from the Bech32 one in its checksum")
+	}
+
+	cardano := NewCardanoAddress()
+	for _, tt := range []struct {
+		addr string
+		want Bech32Encoding
+	}{
+		{standard, Bech32Standard},
+		{bech32m, Bech32m},
+	} {
+		if !cardano.Validate(tt.addr) {
+			t.Errorf("Validate(%s) = false, want true", tt.addr)
+		}
+		details, err := cardano.Decode(tt.addr)
+		if err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+		if details.Encoding != tt.want {
+			t.Errorf("Decode(%s).Encoding = %d, want %d", tt.addr, details.Encoding, tt.want)
+		}
+		if details.HeaderType != CardanoEnterpriseAddress || hex.EncodeToString(details.PaymentHash) != hex.EncodeToString(blake2b224(pubKey)) {
+			t.Errorf("Decode(%s) = %+v", tt.addr, details)
+		}
+	}
+
+	// WithEncoding keeps the network
+	testnet, _ := NewCardanoTestnetAddress().WithEncoding(Bech32m).Generate(pubKey)
+	if !strings.HasPrefix(testnet, CardanoTestnetHRP+"1") {
+		t.Errorf("testnet Bech32m address = %s, want %s1 prefix", testnet, CardanoTestnetHRP)
+	}
+}
+
+func TestSegWitDecodeProgramLength(t *testing.T) {
+	// BIP-173 and BIP-350 valid vectors, including the 2- and 40-byte boundaries
+	valid := []struct {
+		addr    string
+		version int
+		length  int
+	}{
+		{"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", 0, 20},
+		{"tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7", 0, 32},
+		{"BC1SW50QGDZ25J", 16, 2},
+		{"bc1pw508d6qejxtdg4y5r3zarvary0c5xw7kw508d6qejxtdg4y5r3zarvary0c5xw7kt5nd6y", 1, 40},
+	}
+	for _, tt := range valid {
+		_, version, program, err := SegWitDecode(tt.addr)
+		if err != nil {
+			t.Errorf("SegWitDecode(%s) error = %v", tt.addr, err)
+			continue
+		}
+		if version != tt.version || len(program) != tt.length {
+			t.Errorf("SegWitDecode(%s) = v%d, %d bytes, want v%d, %d bytes", tt.addr, version, len(program), tt.version, tt.length)
+		}
+	}
+
+	// SegWitEncode doesn't check program lengths, so it can build the invalid cases
+	invalid := []struct {
+		name    string
+		version int
+		length  int
+	}{
+		{"version 0, 19 bytes", 0, 19},
+		{"version 0, 21 bytes", 0, 21},
+		{"1 byte", 1, 1},
+		{"41 bytes", 1, 41},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, err := SegWitEncode("bc", tt.version, make([]byte, tt.length))
+			if err != nil {
+				t.Fatalf("SegWitEncode() error = %v", err)
+			}
+			if _, _, _, err := SegWitDecode(addr); !errors.Is(err, ErrInvalidWitness) {
+				t.Errorf("SegWitDecode(%s) error = %v, want %v", addr, err, ErrInvalidWitness)
+			}
+			if NewBitcoinAddress(false).Validate(addr) {
+				t.Errorf("Validate(%s) = true, want false", addr)
+			}
+		})
+	}
+
+	// BIP-173 invalid vectors: non-zero padding and more than 4 padding bits
+	for _, addr := range []string{
+		"bc1zw508d6qejxtdg4y5r3zarvaryvqyzf3du",
+		"tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3pjxtptv",
+	} {
+		if _, _, _, err := SegWitDecode(addr); err == nil {
+			t.Errorf("SegWitDecode(%s) error = nil, want error", addr)
+		}
+	}
+}
+
+func TestBitcoinAllAddresses(t *testing.T) {
+	btc := NewBitcoinAddress(false)
+	pubKey, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+
+	addresses, err := btc.AllAddresses(pubKey)
+	if err != nil {
+		t.Fatalf("AllAddresses() error = %v", err)
+	}
+
+	want := map[string]string{
+		BitcoinScriptP2PKH:      "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+		BitcoinScriptP2SHP2WPKH: "3JvL6Ymt8MVWiCNHC7oWU6nLeHNJKLZGLN",
+		BitcoinScriptP2WPKH:     "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+		BitcoinScriptP2TR:       "bc1pmfr3p9j00pfxjh0zmgp99y8zftmd3s5pmedqhyptwy6lm87hf5sspknck9",
+	}
+	if len(addresses) != len(want) {
+		t.Errorf("AllAddresses() returned %d addresses, want %d", len(addresses), len(want))
+	}
+	for scriptType, addr := range want {
+		if addresses[scriptType] != addr {
+			t.Errorf("AllAddresses()[%s] = %s, want %s", scriptType, addresses[scriptType], addr)
+		}
+		if !btc.Validate(addresses[scriptType]) {
+			t.Errorf("Validate(%s) = false", addresses[scriptType])
+		}
+	}
+
+	// BIP-86 test vector: m/86'/0'/0'/0/0 of the "abandon ... about" mnemonic
+	internalKey, _ := hex.DecodeString("cc8a4bc64d897bddc5fbc2f670f7a8ba0b386779106cf1223c6fc5d7cd6fc115")
+	outputKey, err := TaprootOutputKey(internalKey)
+	if err != nil {
+		t.Fatalf("TaprootOutputKey() error = %v", err)
+	}
+	if got := hex.EncodeToString(outputKey); got != "a60869f0dbcf1dc659c9cecbaf8050135ea9e8cdc487053f1dc6880949dc684c" {
+		t.Errorf("TaprootOutputKey() = %s", got)
+	}
+	if addr, _ := btc.P2TR(outputKey); addr != "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr" {
+		t.Errorf("P2TR() = %s, want bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr", addr)
+	}
+
+	if _, err := btc.AllAddresses(make([]byte, 65)); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Errorf("AllAddresses(65 bytes) error = %v, want %v", err, ErrInvalidPublicKey)
+	}
+}
+
+func TestBitcoinP2PKHCompressedVsUncompressed(t *testing.T) {
+	// Private key 1: the public key is the generator point G
+	compressed, _ := hex.DecodeString("0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798")
+	uncompressed, _ := hex.DecodeString("0479BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798" +
+		"483ADA7726A3C4655DA4FBFC0E1108A8FD17B448A68554199C47D08FFB10D4B8")
+
+	btc := NewBitcoinAddress(false)
+	tests := []struct {
+		name   string
+		pubKey []byte
+		want   string
+	}{
+		{"compressed", compressed, "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"},
+		{"uncompressed", uncompressed, "1EHNa6Q4Jz2uvNExL497mE43ikXhwF6kZm"},
+	}
+
+	for _, tt := range tests {
+		addr, err := btc.P2PKH(tt.pubKey)
+		if err != nil {
+			t.Fatalf("P2PKH(%s) error = %v", tt.name, err)
+		}
+		if addr != tt.want {
+			t.Errorf("P2PKH(%s) = %s, want %s", tt.name, addr, tt.want)
+		}
+		if !btc.Validate(addr) {
+			t.Errorf("Validate(%s) = false, want true", addr)
+		}
+	}
+
+	// SegWit only commits to compressed keys
+	if _, err := btc.P2WPKH(uncompressed); err == nil {
+		t.Error("P2WPKH(uncompressed) error = nil, want error")
+	}
+}
+
+func TestBitcoinDecodeSegWit(t *testing.T) {
+	tests := []struct {
+		addr    string
+		testnet bool
+		typ     AddressType
+		version byte
+		network Network
+	}{
+		{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", false, AddressTypeBitcoinP2WPKH, 0, NetworkMainnet},
+		{"bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3", false, AddressTypeBitcoinP2WSH, 0, NetworkMainnet},
+		{"bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr", false, AddressTypeBitcoinP2TR, 1, NetworkMainnet},
+		{"tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", true, AddressTypeBitcoinP2WPKH, 0, NetworkTestnet},
+	}
+
+	for _, tt := range tests {
+		info, err := NewBitcoinAddress(tt.testnet).DecodeAddress(tt.addr)
+		if err != nil {
+			t.Fatalf("DecodeAddress(%s) error = %v", tt.addr, err)
+		}
+		if info.Type != tt.typ {
+			t.Errorf("DecodeAddress(%s).Type = %s, want %s", tt.addr, info.Type, tt.typ)
+		}
+		if info.Version != tt.version {
+			t.Errorf("DecodeAddress(%s).Version = %d, want %d", tt.addr, info.Version, tt.version)
+		}
+		if info.Network != tt.network {
+			t.Errorf("DecodeAddress(%s).Network = %v, want %v", tt.addr, info.Network, tt.network)
+		}
+	}
+}
+
+func TestBitcoinDecodeNetwork(t *testing.T) {
+	pubKey, _ := hex.DecodeString("0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798")
+
+	for _, encodeTestnet := range []bool{false, true} {
+		gen := NewBitcoinAddress(encodeTestnet)
+		p2pkh, err := gen.P2PKH(pubKey)
+		if err != nil {
+			t.Fatalf("P2PKH() error = %v", err)
+		}
+		p2wpkh, err := gen.P2WPKH(pubKey)
+		if err != nil {
+			t.Fatalf("P2WPKH() error = %v", err)
+		}
+
+		for _, decodeTestnet := range []bool{false, true} {
+			decoder := NewBitcoinAddress(decodeTestnet)
+			for _, addr := range []string{p2pkh, p2wpkh} {
+				info, err := decoder.DecodeAddress(addr)
+				if encodeTestnet != decodeTestnet {
+					if !errors.Is(err, ErrNetworkMismatch) {
+						t.Errorf("DecodeAddress(%s) on testnet=%v error = %v, want ErrNetworkMismatch", addr, decodeTestnet, err)
+					}
+					continue
+				}
+
+				if err != nil {
+					t.Fatalf("DecodeAddress(%s) error = %v", addr, err)
+				}
+				if info.Network != decoder.network() {
+					t.Errorf("DecodeAddress(%s).Network = %s, want %s", addr, info.Network, decoder.network())
+				}
+			}
+		}
+	}
+}
+
+func TestSilentPaymentAddress(t *testing.T) {
+	scanKey, _ := hex.DecodeString("0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798")
+	spendKey, _ := hex.DecodeString("02C6047F9441ED7D6D3045406E95C07CD85C778E4B8CEF3CA7ABAC09B95C709EE5")
+
+	for _, testnet := range []bool{false, true} {
+		sp := NewSilentPaymentAddress(testnet)
+		addr, err := sp.Generate(scanKey, spendKey)
+		if err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+		if !strings.HasPrefix(addr, sp.hrp()+"1q") {
+			t.Errorf("Generate() = %s, want %s1q prefix", addr, sp.hrp())
+		}
+
+		gotScan, gotSpend, err := sp.Decode(addr)
+		if err != nil {
+			t.Fatalf("Decode(%s) error = %v", addr, err)
+		}
+		if !bytes.Equal(gotScan, scanKey) || !bytes.Equal(gotSpend, spendKey) {
+			t.Errorf("Decode(%s) = %x, %x, want %x, %x", addr, gotScan, gotSpend, scanKey, spendKey)
+		}
+
+		if _, _, err := NewSilentPaymentAddress(!testnet).Decode(addr); !errors.Is(err, ErrNetworkMismatch) {
+			t.Errorf("Decode(%s) on the other network error = %v, want ErrNetworkMismatch", addr, err)
+		}
+	}
+
+	// BIP-352 test vector address
+	if !NewSilentPaymentAddress(false).Validate("sp1qqgste7k9hx0qftg6qmwlkqtwuy6cycyavzmzj85c6qdfhjdpdjtdgqjuexzk6murw56suy3e0rd2cgqvycxttddwsvgxe2usfpxumr70xc9pkqwv") {
+		t.Error("Validate() rejected the BIP-352 test vector address")
+	}
+
+	if _, err := NewSilentPaymentAddress(false).Generate(scanKey[1:], spendKey); err == nil {
+		t.Error("Generate() with a 32-byte scan key should fail")
+	}
+}
+
+func TestBitcoinScriptPubKey(t *testing.T) {
+	redeemScript, _ := hex.DecodeString("0014751e76e8199196d454941c45d1b3a323f1433bd6")
+	p2sh, _ := NewBitcoinAddress(false).P2SH(redeemScript)
+
+	tests := []struct {
+		address string
+		want    string
+	}{
+		{"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", "76a914751e76e8199196d454941c45d1b3a323f1433bd688ac"},
+		{p2sh, "a914" + hex.EncodeToString(Hash160(redeemScript)) + "87"},
+		{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", "0014751e76e8199196d454941c45d1b3a323f1433bd6"},
+		{"bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3", "00201863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262"},
+		{"bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr", "5120a60869f0dbcf1dc659c9cecbaf8050135ea9e8cdc487053f1dc6880949dc684c"},
+	}
+
+	btc := NewBitcoinAddress(false)
+	for _, tt := range tests {
+		got, err := btc.ScriptPubKey(tt.address)
+		if err != nil {
+			t.Fatalf("ScriptPubKey(%s) error = %v", tt.address, err)
+		}
+		if hex.EncodeToString(got) != tt.want {
+			t.Errorf("ScriptPubKey(%s) = %x, want %s", tt.address, got, tt.want)
+		}
+	}
+
+	if _, err := NewBitcoinAddress(true).ScriptPubKey("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"); !errors.Is(err, ErrNetworkMismatch) {
+		t.Errorf("ScriptPubKey() on testnet error = %v, want ErrNetworkMismatch", err)
+	}
+}
+
+func TestBitcoinP2PK(t *testing.T) {
+	// The genesis block's coinbase pays to an uncompressed key
+	genesisKey, _ := hex.DecodeString("04678afdb0fe5548271967f1a67130b7105cd6a828e03909a67962e0ea1f61deb649f6bc3f4cef38c4f35504e51ec112de5c384df7ba0b8d578a4c702b6bf11d5f")
+	compressedKey, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+
+	tests := []struct {
+		name      string
+		publicKey []byte
+		address   string
+	}{
+		{"uncompressed", genesisKey, "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa"},
+		{"compressed", compressedKey, "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH"},
+	}
+
+	btc := NewBitcoinAddress(false)
+	for _, tt := range tests {
+		wantScript := hex.EncodeToString(append([]byte{byte(len(tt.publicKey))}, tt.publicKey...)) + "ac"
+		if script := btc.P2PKScript(tt.publicKey); hex.EncodeToString(script) != wantScript {
+			t.Errorf("P2PKScript(%s) = %x, want %s", tt.name, script, wantScript)
+		}
+		if script, err := btc.ScriptPubKey(hex.EncodeToString(tt.publicKey)); err != nil || hex.EncodeToString(script) != wantScript {
+			t.Errorf("ScriptPubKey(%s key) = %x, %v, want %s", tt.name, script, err, wantScript)
+		}
+
+		addr, err := btc.P2PKToAddress(tt.publicKey)
+		if err != nil {
+			t.Fatalf("P2PKToAddress(%s) error = %v", tt.name, err)
+		}
+		if addr != tt.address {
+			t.Errorf("P2PKToAddress(%s) = %s, want %s", tt.name, addr, tt.address)
+		}
+	}
+
+	if btc.P2PKScript(compressedKey[1:]) != nil {
+		t.Error("P2PKScript() should reject a 32-byte key")
+	}
+	if _, err := btc.P2PKToAddress(make([]byte, 33)); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Errorf("P2PKToAddress() of an invalid key error = %v, want ErrInvalidPublicKey", err)
+	}
+}
+
+func TestDefaultPath(t *testing.T) {
+	tests := []struct {
+		chainID    ChainID
+		scriptType string
+		want       string
+	}{
+		{ChainBitcoin, "", "m/44'/0'/0'/0/0"},
+		{ChainBitcoin, "legacy", "m/44'/0'/0'/0/0"},
+		{ChainBitcoin, BitcoinScriptP2SHP2WPKH, "m/49'/0'/0'/0/0"},
+		{ChainBitcoin, "segwit", "m/84'/0'/0'/0/0"},
+		{ChainBitcoin, "taproot", "m/86'/0'/0'/0/0"},
+		{ChainEthereum, "", "m/44'/60'/0'/0/0"},
+		{ChainBase, "", "m/44'/60'/0'/0/0"},
+		{ChainSolana, "", "m/44'/501'/0'/0'"},
+	}
+
+	for _, tt := range tests {
+		got, err := DefaultPath(tt.chainID, tt.scriptType)
+		if err != nil {
+			t.Fatalf("DefaultPath(%s, %q) error = %v", tt.chainID, tt.scriptType, err)
+		}
+		if got != tt.want {
+			t.Errorf("DefaultPath(%s, %q) = %s, want %s", tt.chainID, tt.scriptType, got, tt.want)
+		}
+	}
+
+	if got, _ := DerivationPath(ChainBitcoin, BitcoinScriptP2WPKH, 1, 5); got != "m/84'/0'/1'/0/5" {
+		t.Errorf("DerivationPath(btc, p2wpkh, 1, 5) = %s, want m/84'/0'/1'/0/5", got)
+	}
+
+	if _, err := DefaultPath(ChainEthereum, "taproot"); !errors.Is(err, ErrUnsupportedChain) {
+		t.Errorf("DefaultPath(eth, taproot) error = %v, want ErrUnsupportedChain", err)
+	}
+	if _, err := DefaultPath(ChainBitcoin, "p2pk"); err == nil {
+		t.Error("DefaultPath(btc, p2pk) should fail for an unknown script type")
+	}
+}
+
+func TestDetectChains(t *testing.T) {
+	chains := DetectChains("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2")
+	if len(chains) != 1 || chains[0] != ChainBitcoin {
+		t.Errorf("DetectChains(bitcoin address) = %v, want [btc]", chains)
+	}
+
+	matched := make(map[ChainID]bool)
+	for _, chainID := range DetectChains("0x9858EfFD232B4033E47d90003D41EC34EcaEda94") {
+		matched[chainID] = true
+	}
+	for chainID := range EVMChains() {
+		if !matched[chainID] {
+			t.Errorf("DetectChains(0x address) does not include EVM chain %s", chainID)
+		}
+	}
+
+	if chains := DetectChains("not an address!"); len(chains) != 0 {
+		t.Errorf("DetectChains(invalid) = %v, want none", chains)
+	}
+}
@@ -1,8 +1,10 @@
 package address
 
 import (
+	"crypto/subtle"
 	"encoding/base32"
-	"fmt"
+
+	"github.com/study/crypto-accounts/pkgs/crypto/hash"
 )
 
 // Custom Base32 encoding for Algorand (no padding)
@@ -17,21 +19,30 @@ func NewAlgorandAddress() *AlgorandAddress {
 	return &AlgorandAddress{}
 }
 
+func init() {
+	registerChain(ChainAlgorand, func() AddressGenerator { return NewAlgorandAddress() }, nil)
+}
+
 // ChainID returns the chain identifier
 func (a *AlgorandAddress) ChainID() ChainID {
 	return ChainAlgorand
 }
 
+// Info describes the chain
+func (a *AlgorandAddress) Info() ChainInfo {
+	return ChainInfo{ChainAlgorand, "Algorand", "ALGO", "Base32", "58 characters"}
+}
+
 // Generate creates an Algorand address from a public key
 // Public key should be 32 bytes (Ed25519 public key)
 func (a *AlgorandAddress) Generate(publicKey []byte) (string, error) {
 	if len(publicKey) != 32 {
-		return "", fmt.Errorf("Algorand requires 32-byte Ed25519 public key, got %d bytes", len(publicKey))
+		return "", keyLengthError(a.ChainID(), len(publicKey), 32)
 	}
 
 	// Calculate checksum: last 4 bytes of SHA512/256 hash
-	hash := SHA256Hash(publicKey) // Using SHA256 as approximation; real Algorand uses SHA512/256
-	checksum := hash[len(hash)-4:]
+	digest := hash.SHA512_256(publicKey)
+	checksum := digest[len(digest)-4:]
 
 	// Create final data: public key + checksum
 	final := make([]byte, 36)
@@ -61,13 +72,11 @@ func (a *AlgorandAddress) Validate(address string) bool {
 	// Verify checksum
 	publicKey := decoded[:32]
 	checksum := decoded[32:]
-	hash := SHA256Hash(publicKey)
-	expectedChecksum := hash[len(hash)-4:]
+	digest := hash.SHA512_256(publicKey)
+	expectedChecksum := digest[len(digest)-4:]
 
-	for i := 0; i < 4; i++ {
-		if checksum[i] != expectedChecksum[i] {
-			return false
-		}
+	if subtle.ConstantTimeCompare(checksum, expectedChecksum) != 1 {
+		return false
 	}
 
 	return true
@@ -21,11 +21,20 @@ func NewAptosAddress() *AptosAddress {
 	return &AptosAddress{}
 }
 
+func init() {
+	registerChain(ChainAptos, func() AddressGenerator { return NewAptosAddress() }, nil)
+}
+
 // ChainID returns the chain identifier
 func (a *AptosAddress) ChainID() ChainID {
 	return ChainAptos
 }
 
+// Info describes the chain
+func (a *AptosAddress) Info() ChainInfo {
+	return ChainInfo{ChainAptos, "Aptos", "APT", "Hex", "0x-prefixed, 64 hex chars"}
+}
+
 // Generate creates an Aptos address from an Ed25519 public key
 // Public key should be 32 bytes
 func (a *AptosAddress) Generate(publicKey []byte) (string, error) {
@@ -45,7 +54,7 @@ func (a *AptosAddress) GenerateWithScheme(publicKey []byte, scheme byte) (string
 	}
 
 	if len(publicKey) != expectedLen {
-		return "", fmt.Errorf("invalid public key length: expected %d, got %d", expectedLen, len(publicKey))
+		return "", keyLengthError(a.ChainID(), len(publicKey), expectedLen)
 	}
 
 	// Aptos address generation:
@@ -103,6 +112,6 @@ func (a *AptosAddress) DecodeAddress(address string) (*AddressInfo, error) {
 		Address:   address,
 		PublicKey: decoded,
 		ChainID:   ChainAptos,
-		Type:      AddressTypeBase58, // Actually hex, but no specific type
+		Type:      AddressTypeHex,
 	}, nil
 }
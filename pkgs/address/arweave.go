@@ -16,11 +16,20 @@ func NewArweaveAddress() *ArweaveAddress {
 	return &ArweaveAddress{}
 }
 
+func init() {
+	registerChain(ChainArweave, func() AddressGenerator { return NewArweaveAddress() }, nil)
+}
+
 // ChainID returns the chain identifier
 func (a *ArweaveAddress) ChainID() ChainID {
 	return ChainArweave
 }
 
+// Info describes the chain
+func (a *ArweaveAddress) Info() ChainInfo {
+	return ChainInfo{ChainArweave, "Arweave", "AR", "Base64URL", "43 characters (SHA-256)"}
+}
+
 // Generate creates an Arweave address from an RSA public key
 // The public key should be the modulus (n) of the RSA key in raw bytes
 // Typically 4096 bits = 512 bytes for Arweave
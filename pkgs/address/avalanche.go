@@ -32,11 +32,20 @@ func NewAvalancheCChainAddress() *EthereumAddress {
 	return NewEVMAddress(ChainAvalanche)
 }
 
+func init() {
+	registerChain(ChainAvalanche, func() AddressGenerator { return NewAvalancheCChainAddress() }, nil)
+}
+
 // ChainID returns the chain identifier
 func (a *AvalancheAddress) ChainID() ChainID {
 	return ChainAvalanche
 }
 
+// Info describes the chain
+func (a *AvalancheAddress) Info() ChainInfo {
+	return ChainInfo{ChainAvalanche, "Avalanche", "AVAX", "Bech32/Ethereum", "X/P-Chain: Bech32, C-Chain: Ethereum"}
+}
+
 // Generate creates an Avalanche address from a public key
 // For X-Chain and P-Chain: 33 bytes compressed secp256k1
 func (a *AvalancheAddress) Generate(publicKey []byte) (string, error) {
@@ -45,7 +54,7 @@ func (a *AvalancheAddress) Generate(publicKey []byte) (string, error) {
 	}
 
 	if len(publicKey) != 33 {
-		return "", fmt.Errorf("Avalanche X/P-Chain requires 33-byte compressed public key")
+		return "", keyLengthError(a.ChainID(), len(publicKey), 33)
 	}
 
 	// Hash160 of public key
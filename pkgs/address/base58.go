@@ -1,39 +1,35 @@
 package address
 
 import (
-	"bytes"
-	"fmt"
-	"math/big"
+	"crypto/subtle"
+
+	"github.com/study/crypto-accounts/pkgs/crypto/encoding"
 )
 
 // Base58 alphabets for different chains
 const (
 	// Bitcoin/standard Base58 alphabet
-	BitcoinAlphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	BitcoinAlphabet = encoding.BitcoinAlphabet
 
 	// Ripple Base58 alphabet
-	RippleAlphabet = "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz"
+	RippleAlphabet = encoding.RippleAlphabet
 
 	// Flickr Base58 alphabet (used by some chains)
-	FlickrAlphabet = "123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ"
+	FlickrAlphabet = encoding.FlickrAlphabet
 )
 
 // Base58Encoder provides Base58 encoding/decoding
 type Base58Encoder struct {
-	alphabet   string
-	alphabetMap map[byte]int
+	alphabet string
 }
 
-// NewBase58Encoder creates a new Base58 encoder with the given alphabet
+// NewBase58Encoder creates a new Base58 encoder with the given alphabet.
+// Like base64.NewEncoding, it panics if the alphabet is not 58 unique characters.
 func NewBase58Encoder(alphabet string) *Base58Encoder {
-	enc := &Base58Encoder{
-		alphabet:   alphabet,
-		alphabetMap: make(map[byte]int),
-	}
-	for i := 0; i < len(alphabet); i++ {
-		enc.alphabetMap[alphabet[i]] = i
+	if err := encoding.ValidateBase58Alphabet(alphabet); err != nil {
+		panic(err)
 	}
-	return enc
+	return &Base58Encoder{alphabet: alphabet}
 }
 
 // defaultBase58 is the Bitcoin Base58 encoder
@@ -51,82 +47,14 @@ func Base58Decode(str string) ([]byte, error) {
 
 // Encode encodes data to Base58
 func (e *Base58Encoder) Encode(data []byte) string {
-	if len(data) == 0 {
-		return ""
-	}
-
-	// Count leading zeros
-	leadingZeros := 0
-	for _, b := range data {
-		if b == 0 {
-			leadingZeros++
-		} else {
-			break
-		}
-	}
-
-	// Convert to big integer
-	num := new(big.Int).SetBytes(data)
-	base := big.NewInt(58)
-	zero := big.NewInt(0)
-	mod := new(big.Int)
-
-	var result []byte
-	for num.Cmp(zero) > 0 {
-		num.DivMod(num, base, mod)
-		result = append(result, e.alphabet[mod.Int64()])
-	}
-
-	// Add leading zeros
-	for i := 0; i < leadingZeros; i++ {
-		result = append(result, e.alphabet[0])
-	}
-
-	// Reverse the result
-	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
-		result[i], result[j] = result[j], result[i]
-	}
-
-	return string(result)
+	// The alphabet was validated by NewBase58Encoder
+	encoded, _ := encoding.Base58EncodeWithAlphabet(data, e.alphabet)
+	return encoded
 }
 
 // Decode decodes a Base58 string
 func (e *Base58Encoder) Decode(str string) ([]byte, error) {
-	if len(str) == 0 {
-		return nil, nil
-	}
-
-	// Count leading alphabet[0] characters
-	leadingZeros := 0
-	for _, c := range str {
-		if c == rune(e.alphabet[0]) {
-			leadingZeros++
-		} else {
-			break
-		}
-	}
-
-	// Convert from base58 to big integer
-	num := big.NewInt(0)
-	base := big.NewInt(58)
-
-	for _, c := range str {
-		idx, ok := e.alphabetMap[byte(c)]
-		if !ok {
-			return nil, fmt.Errorf("invalid character '%c' in Base58 string", c)
-		}
-		num.Mul(num, base)
-		num.Add(num, big.NewInt(int64(idx)))
-	}
-
-	// Convert to bytes
-	decoded := num.Bytes()
-
-	// Add leading zeros
-	result := make([]byte, leadingZeros+len(decoded))
-	copy(result[leadingZeros:], decoded)
-
-	return result, nil
+	return encoding.Base58DecodeWithAlphabet(str, e.alphabet)
 }
 
 // Base58CheckEncode encodes data with version byte and checksum
@@ -159,7 +87,7 @@ func Base58CheckDecode(str string) (version byte, payload []byte, err error) {
 
 	// Verify checksum
 	expectedChecksum := Checksum4(decoded[:len(decoded)-4])
-	if !bytes.Equal(checksum, expectedChecksum) {
+	if subtle.ConstantTimeCompare(checksum, expectedChecksum) != 1 {
 		return 0, nil, ErrInvalidChecksum
 	}
 
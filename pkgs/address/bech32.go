@@ -112,6 +112,29 @@ func Bech32Encode(hrp string, data []byte, encoding Bech32Encoding) (string, err
 
 // Bech32Decode decodes a Bech32 string
 func Bech32Decode(str string) (hrp string, data []byte, encoding Bech32Encoding, err error) {
+	hrp, intData, encoding, err := bech32DecodeGroups(str)
+	if err != nil {
+		return "", nil, 0, err
+	}
+
+	// Convert back to 8-bit
+	converted, err := convertBits(intData, 5, 8, false)
+	if err != nil {
+		return "", nil, 0, err
+	}
+
+	// Convert []int to []byte
+	result := make([]byte, len(converted))
+	for i, v := range converted {
+		result[i] = byte(v)
+	}
+
+	return hrp, result, encoding, nil
+}
+
+// bech32DecodeGroups verifies a Bech32 string and returns its 5-bit data
+// groups with the checksum removed
+func bech32DecodeGroups(str string) (hrp string, data []int, encoding Bech32Encoding, err error) {
 	// Check for mixed case
 	lower := strings.ToLower(str)
 	upper := strings.ToUpper(str)
@@ -148,19 +171,34 @@ func Bech32Decode(str string) (hrp string, data []byte, encoding Bech32Encoding,
 		return "", nil, 0, ErrInvalidChecksum
 	}
 
-	// Remove checksum and convert back to 8-bit
-	converted, err := convertBits(intData[:len(intData)-6], 5, 8, false)
-	if err != nil {
-		return "", nil, 0, err
+	return hrp, intData[:len(intData)-6], encoding, nil
+}
+
+// HRPOf returns the lowercase human-readable part of a bech32 or bech32m address
+// without verifying its checksum. It reports false if the string cannot be bech32.
+func HRPOf(addr string) (string, bool) {
+	lower := strings.ToLower(addr)
+	if addr != lower && addr != strings.ToUpper(addr) {
+		return "", false
 	}
 
-	// Convert []int to []byte
-	result := make([]byte, len(converted))
-	for i, v := range converted {
-		result[i] = byte(v)
+	pos := strings.LastIndex(lower, "1")
+	if pos < 1 || pos+7 > len(lower) || len(lower) > 1023 {
+		return "", false
 	}
 
-	return hrp, result, encoding, nil
+	for _, c := range []byte(lower[:pos]) {
+		if c < 33 || c > 126 {
+			return "", false
+		}
+	}
+	for _, c := range []byte(lower[pos+1:]) {
+		if _, ok := bech32CharsetMap[c]; !ok {
+			return "", false
+		}
+	}
+
+	return lower[:pos], true
 }
 
 // convertBits converts between bit groupings
@@ -241,9 +279,15 @@ func SegWitEncode(hrp string, witnessVersion int, witnessProgram []byte) (string
 	return result.String(), nil
 }
 
-// SegWitDecode decodes a SegWit address
+// SegWitDecode decodes a SegWit address.
+// Per BIP-173 the witness program must be 2 to 40 bytes, and exactly 20 or 32
+// bytes for version 0; padding bits left over from the 5-bit groups must be zero.
 func SegWitDecode(str string) (hrp string, witnessVersion int, witnessProgram []byte, err error) {
-	hrp, data, encoding, err := Bech32Decode(str)
+	if len(str) > 90 {
+		return "", 0, nil, fmt.Errorf("segwit address exceeds 90 characters")
+	}
+
+	hrp, data, encoding, err := bech32DecodeGroups(str)
 	if err != nil {
 		return "", 0, nil, err
 	}
@@ -252,11 +296,10 @@ func SegWitDecode(str string) (hrp string, witnessVersion int, witnessProgram []
 		return "", 0, nil, fmt.Errorf("empty data")
 	}
 
-	// Get witness version from decoded data before conversion
-	lower := strings.ToLower(str)
-	pos := strings.LastIndex(lower, "1")
-	dataStr := lower[pos+1:]
-	witnessVersion = bech32CharsetMap[dataStr[0]]
+	witnessVersion = data[0]
+	if witnessVersion > 16 {
+		return "", 0, nil, fmt.Errorf("invalid witness version: %d", witnessVersion)
+	}
 
 	// Verify encoding matches version
 	if witnessVersion == 0 && encoding != Bech32Standard {
@@ -266,24 +309,19 @@ func SegWitDecode(str string) (hrp string, witnessVersion int, witnessProgram []
 		return "", 0, nil, fmt.Errorf("invalid encoding for witness version > 0")
 	}
 
-	// The data returned from Bech32Decode already has the witness version as the first byte
-	// but since we converted from 5-bit to 8-bit, we need to decode differently
-
-	// Re-decode to get 5-bit data
-	intData := make([]int, len(dataStr))
-	for i, c := range []byte(dataStr) {
-		intData[i] = bech32CharsetMap[c]
-	}
-
-	// Remove checksum and witness version
-	programData := intData[1 : len(intData)-6]
-
-	// Convert 5-bit to 8-bit
-	program, err := convertBits(programData, 5, 8, false)
+	// Convert the program (after the version group) from 5-bit to 8-bit
+	program, err := convertBits(data[1:], 5, 8, false)
 	if err != nil {
 		return "", 0, nil, err
 	}
 
+	if len(program) < 2 || len(program) > 40 {
+		return "", 0, nil, fmt.Errorf("%w: %d bytes, want 2 to 40", ErrInvalidWitness, len(program))
+	}
+	if witnessVersion == 0 && len(program) != 20 && len(program) != 32 {
+		return "", 0, nil, fmt.Errorf("%w: version 0 program is %d bytes, want 20 or 32", ErrInvalidWitness, len(program))
+	}
+
 	witnessProgram = make([]byte, len(program))
 	for i, v := range program {
 		witnessProgram[i] = byte(v)
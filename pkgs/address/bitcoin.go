@@ -1,7 +1,10 @@
 package address
 
 import (
+	"encoding/hex"
 	"fmt"
+
+	"github.com/study/crypto-accounts/pkgs/crypto/secp256k1"
 )
 
 // Bitcoin address version bytes
@@ -17,6 +20,14 @@ const (
 	BitcoinTestnetBech32HRP         = "tb"
 )
 
+// Bitcoin script types, the keys of AllAddresses
+const (
+	BitcoinScriptP2PKH      = "p2pkh"
+	BitcoinScriptP2SHP2WPKH = "p2sh-p2wpkh"
+	BitcoinScriptP2WPKH     = "p2wpkh"
+	BitcoinScriptP2TR       = "p2tr"
+)
+
 // BitcoinAddress generates Bitcoin addresses
 type BitcoinAddress struct {
 	testnet bool
@@ -27,15 +38,26 @@ func NewBitcoinAddress(testnet bool) *BitcoinAddress {
 	return &BitcoinAddress{testnet: testnet}
 }
 
+func init() {
+	registerChain(ChainBitcoin,
+		func() AddressGenerator { return NewBitcoinAddress(false) },
+		func() AddressGenerator { return NewBitcoinAddress(true) })
+}
+
 // ChainID returns the chain identifier
 func (b *BitcoinAddress) ChainID() ChainID {
 	return ChainBitcoin
 }
 
+// Info describes the chain
+func (b *BitcoinAddress) Info() ChainInfo {
+	return ChainInfo{ChainBitcoin, "Bitcoin", "BTC", "Base58Check/Bech32", "P2PKH, P2SH, SegWit addresses"}
+}
+
 // P2PKH generates a Pay-to-Public-Key-Hash address (starts with 1 on mainnet)
 func (b *BitcoinAddress) P2PKH(publicKey []byte) (string, error) {
 	if len(publicKey) != 33 && len(publicKey) != 65 {
-		return "", ErrInvalidPublicKey
+		return "", keyLengthError(b.ChainID(), len(publicKey), 33, 65)
 	}
 
 	// Hash160 = RIPEMD160(SHA256(publicKey))
@@ -88,6 +110,17 @@ func (b *BitcoinAddress) P2WPKH(publicKey []byte) (string, error) {
 	return SegWitEncode(hrp, 0, pubKeyHash)
 }
 
+// P2SHP2WPKH generates a P2WPKH address nested in P2SH (BIP-49, starts with 3 on mainnet)
+func (b *BitcoinAddress) P2SHP2WPKH(publicKey []byte) (string, error) {
+	if len(publicKey) != 33 {
+		return "", fmt.Errorf("P2SH-P2WPKH requires compressed public key (33 bytes)")
+	}
+
+	// Redeem script: OP_0 <20-byte pubkey hash>
+	redeemScript := append([]byte{0x00, 0x14}, Hash160(publicKey)...)
+	return b.P2SH(redeemScript)
+}
+
 // P2WSH generates a native SegWit P2WSH address (starts with bc1q on mainnet)
 func (b *BitcoinAddress) P2WSH(witnessScript []byte) (string, error) {
 	if len(witnessScript) == 0 {
@@ -123,6 +156,63 @@ func (b *BitcoinAddress) P2TR(taprootKey []byte) (string, error) {
 	return SegWitEncode(hrp, 1, taprootKey)
 }
 
+// TaprootOutputKey returns the BIP-86 output key for a key-path-only Taproot
+// output: the internal key tweaked by the BIP-341 TapTweak hash with no script
+// tree. publicKey may be 33-byte compressed or 32-byte x-only.
+func TaprootOutputKey(publicKey []byte) ([]byte, error) {
+	var internal []byte
+	switch len(publicKey) {
+	case 32:
+		internal = publicKey
+	case 33:
+		internal = publicKey[1:]
+	default:
+		return nil, keyLengthError(ChainBitcoin, len(publicKey), 32, 33)
+	}
+
+	// BIP-340 keys are implicitly the even-Y point with this x coordinate
+	point, err := secp256k1.LiftX(internal)
+	if err != nil {
+		return nil, ErrInvalidPublicKey
+	}
+
+	tweaked, err := secp256k1.TweakAddPublicKey(point, TaggedHash("TapTweak", internal))
+	if err != nil {
+		return nil, err
+	}
+
+	return tweaked.X.FillBytes(make([]byte, 32)), nil
+}
+
+// AllAddresses returns the P2PKH, P2SH-P2WPKH, P2WPKH and BIP-86 P2TR addresses
+// of a compressed public key, keyed by script type (BitcoinScriptP2PKH, ...)
+func (b *BitcoinAddress) AllAddresses(publicKey []byte) (map[string]string, error) {
+	if len(publicKey) != 33 {
+		return nil, keyLengthError(b.ChainID(), len(publicKey), 33)
+	}
+
+	outputKey, err := TaprootOutputKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make(map[string]string, 4)
+	for scriptType, generate := range map[string]func() (string, error){
+		BitcoinScriptP2PKH:      func() (string, error) { return b.P2PKH(publicKey) },
+		BitcoinScriptP2SHP2WPKH: func() (string, error) { return b.P2SHP2WPKH(publicKey) },
+		BitcoinScriptP2WPKH:     func() (string, error) { return b.P2WPKH(publicKey) },
+		BitcoinScriptP2TR:       func() (string, error) { return b.P2TR(outputKey) },
+	} {
+		addr, err := generate()
+		if err != nil {
+			return nil, err
+		}
+		addresses[scriptType] = addr
+	}
+
+	return addresses, nil
+}
+
 // Generate creates a P2PKH address by default
 func (b *BitcoinAddress) Generate(publicKey []byte) (string, error) {
 	return b.P2PKH(publicKey)
@@ -131,12 +221,16 @@ func (b *BitcoinAddress) Generate(publicKey []byte) (string, error) {
 // Validate checks if an address is valid
 func (b *BitcoinAddress) Validate(address string) bool {
 	// Check for Bech32 addresses
-	if len(address) > 4 {
-		prefix := address[:3]
-		if prefix == "bc1" || prefix == "tb1" {
-			_, _, _, err := SegWitDecode(address)
-			return err == nil
+	if hrp, ok := HRPOf(address); ok && (hrp == BitcoinBech32HRP || hrp == BitcoinTestnetBech32HRP) {
+		if _, _, _, err := SegWitDecode(address); err != nil {
+			return false
 		}
+
+		expected := BitcoinBech32HRP
+		if b.testnet {
+			expected = BitcoinTestnetBech32HRP
+		}
+		return hrp == expected
 	}
 
 	// Check for Base58Check addresses
@@ -156,11 +250,36 @@ func (b *BitcoinAddress) Validate(address string) bool {
 	return false
 }
 
-// DecodeAddress decodes a Bitcoin address and returns address info
+// segWitAddressType classifies a witness program: v0 with 20 bytes is P2WPKH,
+// v0 with 32 bytes is P2WSH and v1 with 32 bytes is P2TR
+func segWitAddressType(witnessVersion, programLen int) AddressType {
+	switch {
+	case witnessVersion == 0 && programLen == 20:
+		return AddressTypeBitcoinP2WPKH
+	case witnessVersion == 0 && programLen == 32:
+		return AddressTypeBitcoinP2WSH
+	case witnessVersion == 1 && programLen == 32:
+		return AddressTypeBitcoinP2TR
+	default:
+		return AddressTypeBitcoinBech32
+	}
+}
+
+// network returns the network this generator produces addresses for
+func (b *BitcoinAddress) network() Network {
+	if b.testnet {
+		return NetworkTestnet
+	}
+	return NetworkMainnet
+}
+
+// DecodeAddress decodes a Bitcoin address and returns address info.
+// Addresses belonging to the other network fail with ErrNetworkMismatch.
 func (b *BitcoinAddress) DecodeAddress(address string) (*AddressInfo, error) {
 	info := &AddressInfo{
 		Address: address,
 		ChainID: ChainBitcoin,
+		Network: b.network(),
 	}
 
 	// Check for Bech32 addresses
@@ -172,23 +291,17 @@ func (b *BitcoinAddress) DecodeAddress(address string) (*AddressInfo, error) {
 				return nil, err
 			}
 
-			info.Type = AddressTypeBitcoinBech32
-			info.PublicKey = program
-
-			// Determine version based on witness program length and version
-			if witnessVersion == 0 {
-				if len(program) == 20 {
-					// P2WPKH
-				} else if len(program) == 32 {
-					// P2WSH
-				}
+			wantHRP := BitcoinBech32HRP
+			if b.testnet {
+				wantHRP = BitcoinTestnetBech32HRP
 			}
-
-			// Check HRP
-			if (hrp == "bc" && b.testnet) || (hrp == "tb" && !b.testnet) {
-				return nil, fmt.Errorf("network mismatch")
+			if hrp != wantHRP {
+				return nil, fmt.Errorf("%w: hrp %q on %s", ErrNetworkMismatch, hrp, b.network())
 			}
 
+			info.Type = segWitAddressType(witnessVersion, len(program))
+			info.Version = byte(witnessVersion)
+			info.PublicKey = program
 			return info, nil
 		}
 	}
@@ -199,17 +312,100 @@ func (b *BitcoinAddress) DecodeAddress(address string) (*AddressInfo, error) {
 		return nil, err
 	}
 
-	info.Version = version
-	info.PublicKey = payload
-
+	var testnet bool
 	switch version {
-	case BitcoinP2PKHVersion, BitcoinTestnetP2PKHVersion:
+	case BitcoinP2PKHVersion:
 		info.Type = AddressTypeBitcoinP2PKH
-	case BitcoinP2SHVersion, BitcoinTestnetP2SHVersion:
+	case BitcoinP2SHVersion:
 		info.Type = AddressTypeBitcoinP2SH
+	case BitcoinTestnetP2PKHVersion:
+		info.Type, testnet = AddressTypeBitcoinP2PKH, true
+	case BitcoinTestnetP2SHVersion:
+		info.Type, testnet = AddressTypeBitcoinP2SH, true
 	default:
 		return nil, ErrInvalidVersion
 	}
+	if testnet != b.testnet {
+		return nil, fmt.Errorf("%w: version 0x%02x on %s", ErrNetworkMismatch, version, b.network())
+	}
 
+	info.Version = version
+	info.PublicKey = payload
 	return info, nil
 }
+
+// Script opcodes used by standard output scripts
+const (
+	opDup         = 0x76
+	opHash160     = 0xa9
+	opEqual       = 0x87
+	opEqualVerify = 0x88
+	opCheckSig    = 0xac
+	op1           = 0x51 // OP_1 through OP_16 follow consecutively
+)
+
+// ScriptPubKey returns the output script that pays to an address:
+//
+//	P2PKH:  OP_DUP OP_HASH160 <20 bytes> OP_EQUALVERIFY OP_CHECKSIG
+//	P2SH:   OP_HASH160 <20 bytes> OP_EQUAL
+//	SegWit: OP_n <witness program>, e.g. OP_0 <20 bytes> for P2WPKH
+//	P2PK:   <public key> OP_CHECKSIG, given a hex public key in place of an address
+//
+// The address must belong to the generator's network.
+func (b *BitcoinAddress) ScriptPubKey(address string) ([]byte, error) {
+	// P2PK outputs have no address, so they are named by their key
+	if publicKey, err := hex.DecodeString(address); err == nil {
+		if script := b.P2PKScript(publicKey); script != nil {
+			return script, nil
+		}
+	}
+
+	info, err := b.DecodeAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	switch info.Type {
+	case AddressTypeBitcoinP2PKH, AddressTypeBitcoinP2SH:
+		if len(info.PublicKey) != 20 {
+			return nil, fmt.Errorf("%w: %d-byte hash", ErrInvalidAddress, len(info.PublicKey))
+		}
+		if info.Type == AddressTypeBitcoinP2SH {
+			script := append([]byte{opHash160, 0x14}, info.PublicKey...)
+			return append(script, opEqual), nil
+		}
+		script := append([]byte{opDup, opHash160, 0x14}, info.PublicKey...)
+		return append(script, opEqualVerify, opCheckSig), nil
+	default:
+		versionOp := byte(0x00)
+		if info.Version > 0 {
+			versionOp = op1 + info.Version - 1
+		}
+		return append([]byte{versionOp, byte(len(info.PublicKey))}, info.PublicKey...), nil
+	}
+}
+
+// P2PKScript returns the pay-to-pubkey output script <public key> OP_CHECKSIG
+// that early coinbase outputs use. It returns nil unless publicKey is a valid
+// 33-byte compressed or 65-byte uncompressed secp256k1 key.
+func (b *BitcoinAddress) P2PKScript(publicKey []byte) []byte {
+	if _, err := secp256k1.ParsePublicKey(publicKey); err != nil {
+		return nil
+	}
+
+	script := make([]byte, 0, len(publicKey)+2)
+	script = append(script, byte(len(publicKey)))
+	script = append(script, publicKey...)
+	return append(script, opCheckSig)
+}
+
+// P2PKToAddress returns the address explorers display for a P2PK output: the
+// P2PKH address of its key, in the key's own compressed or uncompressed form.
+// The output itself can't be paid to through that address.
+func (b *BitcoinAddress) P2PKToAddress(publicKey []byte) (string, error) {
+	if _, err := secp256k1.ParsePublicKey(publicKey); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidPublicKey, err)
+	}
+
+	return b.P2PKH(publicKey)
+}
@@ -11,9 +11,6 @@ const (
 	BCHTypeP2SH  byte = 0x08
 )
 
-// CashAddr charset
-const cashAddrCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
-
 // BitcoinCashAddress generates Bitcoin Cash CashAddr format addresses
 type BitcoinCashAddress struct {
 	testnet bool
@@ -24,15 +21,26 @@ func NewBitcoinCashAddress(testnet bool) *BitcoinCashAddress {
 	return &BitcoinCashAddress{testnet: testnet}
 }
 
+func init() {
+	registerChain(ChainBitcoinCash,
+		func() AddressGenerator { return NewBitcoinCashAddress(false) },
+		func() AddressGenerator { return NewBitcoinCashAddress(true) })
+}
+
 // ChainID returns the chain identifier
 func (b *BitcoinCashAddress) ChainID() ChainID {
 	return ChainBitcoinCash
 }
 
+// Info describes the chain
+func (b *BitcoinCashAddress) Info() ChainInfo {
+	return ChainInfo{ChainBitcoinCash, "Bitcoin Cash", "BCH", "CashAddr", "Starts with 'bitcoincash:'"}
+}
+
 // Generate creates a CashAddr from a public key
 func (b *BitcoinCashAddress) Generate(publicKey []byte) (string, error) {
 	if len(publicKey) != 33 && len(publicKey) != 65 {
-		return "", ErrInvalidPublicKey
+		return "", keyLengthError(b.ChainID(), len(publicKey), 33, 65)
 	}
 
 	// Hash160 of public key
@@ -54,138 +62,41 @@ func (b *BitcoinCashAddress) P2SH(scriptHash []byte) (string, error) {
 	return b.encodeCashAddr(BCHTypeP2SH, scriptHash)
 }
 
-// encodeCashAddr encodes data in CashAddr format
-func (b *BitcoinCashAddress) encodeCashAddr(addrType byte, hash []byte) (string, error) {
-	// Get prefix
-	prefix := "bitcoincash"
+// prefix returns the CashAddr prefix for the configured network
+func (b *BitcoinCashAddress) prefix() string {
 	if b.testnet {
-		prefix = "bchtest"
-	}
-
-	// Create version byte (type + size bits)
-	// For 20-byte hash: size = 0
-	versionByte := addrType // Type in upper 4 bits, size in lower 4 bits
-
-	// Combine version byte and hash
-	payload := make([]byte, 1+len(hash))
-	payload[0] = versionByte
-	copy(payload[1:], hash)
-
-	// Convert to 5-bit groups
-	converted, err := ConvertBitsBytes(payload, 8, 5, true)
-	if err != nil {
-		return "", err
+		return "bchtest"
 	}
-
-	// Calculate checksum
-	checksum := cashAddrChecksum(prefix, converted)
-
-	// Combine data and checksum
-	combined := append(converted, checksum...)
-
-	// Encode to CashAddr charset
-	var result strings.Builder
-	result.WriteString(prefix)
-	result.WriteByte(':')
-	for _, d := range combined {
-		result.WriteByte(cashAddrCharset[d])
-	}
-
-	return result.String(), nil
+	return "bitcoincash"
 }
 
-// cashAddrChecksum calculates the CashAddr checksum
-func cashAddrChecksum(prefix string, data []int) []int {
-	// Expand prefix
-	prefixData := make([]int, len(prefix)+1)
-	for i, c := range prefix {
-		prefixData[i] = int(c) & 0x1f
-	}
-	prefixData[len(prefix)] = 0
-
-	// Combine prefix and data, add 8 zeros for checksum
-	values := append(prefixData, data...)
-	values = append(values, 0, 0, 0, 0, 0, 0, 0, 0)
-
-	// Calculate polymod
-	polymod := cashAddrPolymod(values) ^ 1
-
-	// Extract checksum
-	checksum := make([]int, 8)
-	for i := 0; i < 8; i++ {
-		checksum[i] = (polymod >> uint(5*(7-i))) & 0x1f
-	}
-
-	return checksum
-}
-
-// cashAddrPolymod calculates the BCH polymod
-func cashAddrPolymod(values []int) int {
-	generator := []int{
-		0x98f2bc8e61,
-		0x79b76d99e2,
-		0xf33e5fb3c4,
-		0xae2eabe2a8,
-		0x1e4f43e470,
-	}
-
-	chk := 1
-	for _, v := range values {
-		top := chk >> 35
-		chk = ((chk & 0x07ffffffff) << 5) ^ v
-		for i := 0; i < 5; i++ {
-			if (top>>uint(i))&1 == 1 {
-				chk ^= generator[i]
-			}
-		}
-	}
-	return chk
+// encodeCashAddr encodes data in CashAddr format
+func (b *BitcoinCashAddress) encodeCashAddr(addrType byte, hash []byte) (string, error) {
+	// Version byte: type in bits 3-6, size in bits 0-2 (0 for a 20-byte hash)
+	return CashAddrEncode(b.prefix(), addrType, hash), nil
 }
 
 // Validate checks if a CashAddr is valid
+// The prefix may be omitted, in which case the network's prefix is assumed.
 func (b *BitcoinCashAddress) Validate(address string) bool {
-	// Remove prefix if present
-	lower := strings.ToLower(address)
-
-	var prefix, data string
-	if strings.Contains(lower, ":") {
-		parts := strings.SplitN(lower, ":", 2)
-		prefix = parts[0]
-		data = parts[1]
-	} else {
-		// Assume mainnet
-		prefix = "bitcoincash"
-		data = lower
+	if !strings.Contains(address, ":") {
+		address = b.prefix() + ":" + address
 	}
 
-	// Check prefix
-	expectedPrefix := "bitcoincash"
-	if b.testnet {
-		expectedPrefix = "bchtest"
-	}
-	if prefix != expectedPrefix {
+	prefix, version, payload, err := CashAddrDecode(address)
+	if err != nil {
 		return false
 	}
 
-	// Decode data
-	decoded := make([]int, len(data))
-	for i, c := range []byte(data) {
-		idx := strings.IndexByte(cashAddrCharset, c)
-		if idx < 0 {
-			return false
-		}
-		decoded[i] = idx
+	if prefix != b.prefix() {
+		return false
 	}
 
-	// Verify checksum
-	prefixData := make([]int, len(prefix)+1)
-	for i, c := range prefix {
-		prefixData[i] = int(c) & 0x1f
+	if version != BCHTypeP2PKH && version != BCHTypeP2SH {
+		return false
 	}
-	prefixData[len(prefix)] = 0
 
-	values := append(prefixData, decoded...)
-	return cashAddrPolymod(values) == 0
+	return len(payload) == 20
 }
 
 // ToLegacy converts a CashAddr to legacy Bitcoin address format
@@ -0,0 +1,109 @@
+package address
+
+import (
+	"container/list"
+	"sync"
+)
+
+// validationKey identifies a cached Validate result
+type validationKey struct {
+	chainID ChainID
+	address string
+}
+
+type validationEntry struct {
+	key   validationKey
+	valid bool
+}
+
+// maxCachedAddressLen bounds the addresses worth caching, so arbitrary input
+// can't pin large strings in memory. Every supported address is shorter.
+const maxCachedAddressLen = 128
+
+// validationCache is a fixed-size LRU of Validate results, safe for concurrent use
+type validationCache struct {
+	mu         sync.Mutex
+	size       int
+	order      *list.List // Most recently used first
+	entries    map[validationKey]*list.Element
+	generation uint64 // Bumped by clear, so results computed before it are dropped
+}
+
+func newValidationCache(size int) *validationCache {
+	return &validationCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[validationKey]*list.Element, size),
+	}
+}
+
+// get returns a cached result, marking it most recently used
+func (c *validationCache) get(key validationKey) (valid, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return false, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*validationEntry).valid, true
+}
+
+// currentGeneration returns the generation results computed from now on belong to
+func (c *validationCache) currentGeneration() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation
+}
+
+// put stores a result computed during generation, evicting the least recently
+// used one when full. Results from before the last clear are discarded.
+func (c *validationCache) put(key validationKey, valid bool, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*validationEntry).valid = valid
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	if c.order.Len() >= c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*validationEntry).key)
+	}
+	c.entries[key] = c.order.PushFront(&validationEntry{key, valid})
+}
+
+// clear drops every cached result
+func (c *validationCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.order.Init()
+	clear(c.entries)
+	c.generation++
+}
+
+// WithCache returns a copy of the factory whose Validate remembers the
+// results for the size most recently validated addresses, for services that
+// validate the same addresses repeatedly. The copy has its own generators, so
+// later Register calls on either factory don't affect the other; registering
+// on the cached factory empties its cache. Inputs longer than any address
+// are validated but not cached. A size below 1 disables caching.
+func (f *Factory) WithCache(size int) *Factory {
+	cached := &Factory{
+		generators: f.snapshot(),
+		network:    f.network,
+	}
+	if size > 0 {
+		cached.cache = newValidationCache(size)
+	}
+	return cached
+}
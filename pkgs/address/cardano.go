@@ -2,8 +2,10 @@ package address
 
 import (
 	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 
+	"github.com/study/crypto-accounts/pkgs/crypto/ed25519"
 	"golang.org/x/crypto/blake2b"
 )
 
@@ -35,9 +37,17 @@ const (
 	CardanoKeyHashSize = 28
 )
 
+// CIP-1852 roles (the derivation level below the account: m/1852'/1815'/account'/role/index)
+const (
+	CardanoRoleExternal = 0 // Payment keys for receiving
+	CardanoRoleInternal = 1 // Payment keys for change
+	CardanoRoleStaking  = 2 // Stake keys
+)
+
 // CardanoAddress generates Cardano (ADA) addresses
 type CardanoAddress struct {
-	testnet bool
+	testnet  bool
+	encoding Bech32Encoding // Checksum variant used by the Generate methods
 }
 
 // NewCardanoAddress creates a new Cardano address generator for mainnet
@@ -50,17 +60,35 @@ func NewCardanoTestnetAddress() *CardanoAddress {
 	return &CardanoAddress{testnet: true}
 }
 
+// WithEncoding returns a copy of the generator that encodes addresses with the
+// given Bech32 variant. Shelley addresses use Bech32Standard, the default;
+// Validate and Decode accept either variant.
+func (c *CardanoAddress) WithEncoding(encoding Bech32Encoding) *CardanoAddress {
+	return &CardanoAddress{testnet: c.testnet, encoding: encoding}
+}
+
+func init() {
+	registerChain(ChainCardano,
+		func() AddressGenerator { return NewCardanoAddress() },
+		func() AddressGenerator { return NewCardanoTestnetAddress() })
+}
+
 // ChainID returns the chain identifier
 func (c *CardanoAddress) ChainID() ChainID {
 	return ChainCardano
 }
 
+// Info describes the chain
+func (c *CardanoAddress) Info() ChainInfo {
+	return ChainInfo{ChainCardano, "Cardano", "ADA", "Bech32", "Starts with 'addr1'"}
+}
+
 // Generate creates a Cardano enterprise address from an Ed25519 public key
 // Public key should be 32 bytes (Ed25519)
 // This generates an enterprise address (no staking capability)
 func (c *CardanoAddress) Generate(publicKey []byte) (string, error) {
 	if len(publicKey) != 32 {
-		return "", fmt.Errorf("Cardano requires 32-byte Ed25519 public key, got %d bytes", len(publicKey))
+		return "", keyLengthError(c.ChainID(), len(publicKey), 32)
 	}
 
 	// Generate enterprise address (simpler, no staking)
@@ -95,7 +123,7 @@ func (c *CardanoAddress) GenerateEnterpriseAddress(publicKey []byte) (string, er
 		hrp = CardanoTestnetHRP
 	}
 
-	return Bech32Encode(hrp, addressBytes, Bech32Standard)
+	return Bech32Encode(hrp, addressBytes, c.encoding)
 }
 
 // GenerateBaseAddress creates a base address (payment + staking)
@@ -127,7 +155,7 @@ func (c *CardanoAddress) GenerateBaseAddress(paymentKey, stakeKey []byte) (strin
 		hrp = CardanoTestnetHRP
 	}
 
-	return Bech32Encode(hrp, addressBytes, Bech32Standard)
+	return Bech32Encode(hrp, addressBytes, c.encoding)
 }
 
 // GenerateRewardAddress creates a reward/stake address
@@ -157,7 +185,97 @@ func (c *CardanoAddress) GenerateRewardAddress(stakeKey []byte) (string, error)
 		hrp = CardanoTestnetStakeHRP
 	}
 
-	return Bech32Encode(hrp, addressBytes, Bech32Standard)
+	return Bech32Encode(hrp, addressBytes, c.encoding)
+}
+
+// GeneratePointerAddress creates a pointer address: the payment key hash
+// followed by a pointer to the stake registration certificate at the given
+// slot, transaction index and certificate index
+func (c *CardanoAddress) GeneratePointerAddress(paymentKey []byte, slot, txIndex, certIndex uint64) (string, error) {
+	if len(paymentKey) != 32 {
+		return "", fmt.Errorf("Cardano requires 32-byte Ed25519 public key")
+	}
+
+	// Hash the payment key using Blake2b-224
+	paymentHash := blake2b224(paymentKey)
+
+	// Build address bytes
+	var header byte
+	if c.testnet {
+		header = (CardanoPointerAddress << 4) | CardanoTestnet
+	} else {
+		header = (CardanoPointerAddress << 4) | CardanoMainnet
+	}
+
+	addressBytes := make([]byte, 0, 1+CardanoKeyHashSize+3*10)
+	addressBytes = append(addressBytes, header)
+	addressBytes = append(addressBytes, paymentHash...)
+	for _, n := range []uint64{slot, txIndex, certIndex} {
+		addressBytes = appendCardanoVarUint(addressBytes, n)
+	}
+
+	// Encode with Bech32
+	hrp := CardanoMainnetHRP
+	if c.testnet {
+		hrp = CardanoTestnetHRP
+	}
+
+	return Bech32Encode(hrp, addressBytes, c.encoding)
+}
+
+// appendCardanoVarUint appends n in the pointer encoding: 7 bits per byte,
+// most significant group first, with the high bit set on all but the last byte
+func appendCardanoVarUint(dst []byte, n uint64) []byte {
+	var groups [10]byte
+	i := len(groups) - 1
+	groups[i] = byte(n & 0x7f)
+	for n >>= 7; n > 0; n >>= 7 {
+		i--
+		groups[i] = byte(n&0x7f) | 0x80
+	}
+	return append(dst, groups[i:]...)
+}
+
+// BaseAddressFromAccountKey creates a base address from a CIP-1852 account
+// extended public key (64 bytes: public key || chain code).
+// The payment key is derived at 0/index and the stake key at 2/0.
+func (c *CardanoAddress) BaseAddressFromAccountKey(accountPubKey []byte, index uint32) (string, error) {
+	paymentKey, err := cardanoDeriveAccountChild(accountPubKey, CardanoRoleExternal, index)
+	if err != nil {
+		return "", err
+	}
+	stakeKey, err := cardanoDeriveAccountChild(accountPubKey, CardanoRoleStaking, 0)
+	if err != nil {
+		return "", err
+	}
+
+	return c.GenerateBaseAddress(paymentKey, stakeKey)
+}
+
+// RewardAddressFromAccountKey creates a reward address from a CIP-1852 account
+// extended public key (64 bytes: public key || chain code).
+// The stake key is derived at 2/0.
+func (c *CardanoAddress) RewardAddressFromAccountKey(accountPubKey []byte) (string, error) {
+	stakeKey, err := cardanoDeriveAccountChild(accountPubKey, CardanoRoleStaking, 0)
+	if err != nil {
+		return "", err
+	}
+
+	return c.GenerateRewardAddress(stakeKey)
+}
+
+// cardanoDeriveAccountChild soft-derives the public key at role/index from an account xpub
+func cardanoDeriveAccountChild(accountPubKey []byte, role, index uint32) ([]byte, error) {
+	if len(accountPubKey) != ed25519.ExtendedPublicKeySize {
+		return nil, fmt.Errorf("%w: Cardano account key must be 64 bytes, got %d", ErrInvalidPublicKey, len(accountPubKey))
+	}
+
+	xpub, err := ed25519.DerivePublicPath(accountPubKey, []uint32{role, index})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPublicKey, err)
+	}
+
+	return xpub[:ed25519.PublicKeySize], nil
 }
 
 // Validate checks if a Cardano address is valid
@@ -167,20 +285,12 @@ func (c *CardanoAddress) Validate(address string) bool {
 		return false
 	}
 
-	// Check HRP
-	validHRPs := []string{
-		CardanoMainnetHRP, CardanoTestnetHRP,
-		CardanoMainnetStakeHRP, CardanoTestnetStakeHRP,
-	}
-
-	hrpValid := false
-	for _, valid := range validHRPs {
-		if hrp == valid {
-			hrpValid = true
-			break
-		}
+	// Check HRP for the configured network
+	paymentHRP, stakeHRP := CardanoMainnetHRP, CardanoMainnetStakeHRP
+	if c.testnet {
+		paymentHRP, stakeHRP = CardanoTestnetHRP, CardanoTestnetStakeHRP
 	}
-	if !hrpValid {
+	if hrp != paymentHRP && hrp != stakeHRP {
 		return false
 	}
 
@@ -194,8 +304,13 @@ func (c *CardanoAddress) Validate(address string) bool {
 	addrType := (header >> 4) & 0x0F
 	network := header & 0x0F
 
+	// The stake HRP is reserved for reward addresses, and only they use it
+	if (hrp == stakeHRP) != isCardanoRewardType(addrType) {
+		return false
+	}
+
 	// Validate network tag
-	if network != CardanoMainnet && network != CardanoTestnet {
+	if c.testnet && network != CardanoTestnet || !c.testnet && network != CardanoMainnet {
 		return false
 	}
 
@@ -223,39 +338,136 @@ func (c *CardanoAddress) Validate(address string) bool {
 	return true
 }
 
-// DecodeAddress decodes a Cardano address
-func (c *CardanoAddress) DecodeAddress(address string) (*AddressInfo, error) {
+// IsRewardAddress reports whether address is a valid reward (stake) address
+func (c *CardanoAddress) IsRewardAddress(address string) bool {
+	if !c.Validate(address) {
+		return false
+	}
+
+	_, data, _, err := Bech32Decode(address)
+	if err != nil {
+		return false
+	}
+	return isCardanoRewardType(data[0] >> 4)
+}
+
+// isCardanoRewardType reports whether a header type is a reward address type
+func isCardanoRewardType(addrType byte) bool {
+	return addrType == CardanoRewardAddress || addrType == CardanoRewardScript
+}
+
+// FromHex converts the raw bytes of an address, as cardano-cli prints them,
+// to its Bech32 form. The header must describe a valid address for this
+// generator's network; reward addresses get the stake HRP.
+func (c *CardanoAddress) FromHex(hexStr string) (string, error) {
+	data, err := hex.DecodeString(hexStr)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidAddress, err)
+	}
+	if len(data) == 0 {
+		return "", ErrInvalidAddress
+	}
+
+	hrp := CardanoMainnetHRP
+	switch reward := isCardanoRewardType(data[0] >> 4); {
+	case reward && c.testnet:
+		hrp = CardanoTestnetStakeHRP
+	case reward:
+		hrp = CardanoMainnetStakeHRP
+	case c.testnet:
+		hrp = CardanoTestnetHRP
+	}
+
+	address, err := Bech32Encode(hrp, data, c.encoding)
+	if err != nil {
+		return "", err
+	}
+	if !c.Validate(address) {
+		return "", ErrInvalidAddress
+	}
+
+	return address, nil
+}
+
+// ToHex returns the raw bytes of a Bech32 Cardano address as hex
+func (c *CardanoAddress) ToHex(address string) (string, error) {
+	if !c.Validate(address) {
+		return "", ErrInvalidAddress
+	}
+
+	_, data, _, err := Bech32Decode(address)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(data), nil
+}
+
+// CardanoAddressDetails holds the fields of a decoded Shelley address
+type CardanoAddressDetails struct {
+	HeaderType  byte           // Upper header nibble (CardanoBaseAddress, CardanoRewardAddress, ...)
+	NetworkTag  byte           // Lower header nibble (CardanoMainnet or CardanoTestnet)
+	Network     Network        // NetworkMainnet or NetworkTestnet
+	PaymentHash []byte         // Payment key or script hash; nil for reward addresses
+	StakeHash   []byte         // Stake key or script hash; set for base and reward addresses
+	Encoding    Bech32Encoding // Bech32 variant the address was encoded with
+}
+
+// Decode splits a Cardano address into its network and credential hashes
+func (c *CardanoAddress) Decode(address string) (*CardanoAddressDetails, error) {
 	if !c.Validate(address) {
 		return nil, ErrInvalidAddress
 	}
 
-	hrp, data, _, err := Bech32Decode(address)
+	_, data, encoding, err := Bech32Decode(address)
 	if err != nil {
 		return nil, err
 	}
 
 	header := data[0]
-	addrType := (header >> 4) & 0x0F
-	network := header & 0x0F
-
-	info := &AddressInfo{
-		Address: address,
-		ChainID: ChainCardano,
-		Type:    AddressTypeBech32,
+	details := &CardanoAddressDetails{
+		HeaderType: (header >> 4) & 0x0F,
+		NetworkTag: header & 0x0F,
+		Network:    NetworkMainnet,
+		Encoding:   encoding,
+	}
+	if details.NetworkTag == CardanoTestnet {
+		details.Network = NetworkTestnet
 	}
 
-	// Extract payment key hash for base/enterprise addresses
-	if addrType <= CardanoEnterpriseScript && len(data) > CardanoKeyHashSize {
-		info.PublicKey = data[1 : 1+CardanoKeyHashSize]
+	switch details.HeaderType {
+	case CardanoBaseAddress, CardanoScriptAddress, CardanoBaseScriptAddress, CardanoScriptScriptAddr:
+		details.PaymentHash = data[1 : 1+CardanoKeyHashSize]
+		details.StakeHash = data[1+CardanoKeyHashSize:]
+	case CardanoRewardAddress, CardanoRewardScript:
+		details.StakeHash = data[1:]
+	default:
+		// Enterprise and pointer addresses start with the payment hash
+		details.PaymentHash = data[1 : 1+CardanoKeyHashSize]
 	}
 
-	// Add network info
-	if network == CardanoTestnet {
-		info.Type = AddressTypeBech32 // Could add testnet indicator
+	return details, nil
+}
+
+// DecodeAddress decodes a Cardano address
+// PublicKey holds the payment hash, or the stake hash for reward addresses.
+func (c *CardanoAddress) DecodeAddress(address string) (*AddressInfo, error) {
+	details, err := c.Decode(address)
+	if err != nil {
+		return nil, err
 	}
 
-	// Add HRP info
-	_ = hrp // hrp is validated but not stored in AddressInfo currently
+	info := &AddressInfo{
+		Address:   address,
+		PublicKey: details.PaymentHash,
+		ChainID:   ChainCardano,
+		Type:      AddressTypeBech32,
+		Version:   details.HeaderType<<4 | details.NetworkTag,
+		Network:   details.Network,
+	}
+	if info.PublicKey == nil {
+		info.PublicKey = details.StakeHash
+	}
 
 	return info, nil
 }
@@ -0,0 +1,136 @@
+package address
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CashAddr charset (same as Bech32)
+const cashAddrCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// cashAddrChecksumLength is the number of 5-bit checksum groups (40 bits)
+const cashAddrChecksumLength = 8
+
+// CashAddrEncode encodes a version byte and payload in CashAddr format: "<prefix>:<data>".
+// The version byte is chain-specific: Bitcoin Cash packs the address type and hash
+// size into it, while Kaspa uses it as a plain address version.
+func CashAddrEncode(prefix string, version byte, payload []byte) string {
+	data := make([]byte, 1+len(payload))
+	data[0] = version
+	copy(data[1:], payload)
+
+	// Convert to 5-bit groups (8-bit input always converts with padding)
+	converted, _ := ConvertBitsBytes(data, 8, 5, true)
+
+	checksum := cashAddrChecksum(prefix, converted)
+	combined := append(converted, checksum...)
+
+	var result strings.Builder
+	result.WriteString(prefix)
+	result.WriteByte(':')
+	for _, d := range combined {
+		result.WriteByte(cashAddrCharset[d])
+	}
+
+	return result.String()
+}
+
+// CashAddrDecode decodes a CashAddr string into its prefix, version byte and payload.
+// The prefix is required; mixed-case addresses are rejected.
+func CashAddrDecode(addr string) (prefix string, version byte, payload []byte, err error) {
+	lower := strings.ToLower(addr)
+	if lower != addr && strings.ToUpper(addr) != addr {
+		return "", 0, nil, fmt.Errorf("%w: mixed case", ErrInvalidAddress)
+	}
+
+	sep := strings.LastIndexByte(lower, ':')
+	if sep < 1 {
+		return "", 0, nil, fmt.Errorf("%w: missing prefix", ErrInvalidAddress)
+	}
+	prefix = lower[:sep]
+	dataStr := lower[sep+1:]
+
+	if len(dataStr) <= cashAddrChecksumLength {
+		return "", 0, nil, fmt.Errorf("%w: data too short", ErrInvalidAddress)
+	}
+
+	decoded := make([]int, len(dataStr))
+	for i := 0; i < len(dataStr); i++ {
+		idx := strings.IndexByte(cashAddrCharset, dataStr[i])
+		if idx < 0 {
+			return "", 0, nil, fmt.Errorf("%w: invalid character %q", ErrInvalidAddress, dataStr[i])
+		}
+		decoded[i] = idx
+	}
+
+	// A valid checksum leaves a polymod residue of 1 (the final XOR of the checksum)
+	if cashAddrPolymod(append(cashAddrPrefixExpand(prefix), decoded...)) != 1 {
+		return "", 0, nil, ErrInvalidChecksum
+	}
+
+	converted, err := convertBits(decoded[:len(decoded)-cashAddrChecksumLength], 5, 8, false)
+	if err != nil {
+		return "", 0, nil, fmt.Errorf("%w: %v", ErrInvalidAddress, err)
+	}
+	if len(converted) < 1 {
+		return "", 0, nil, fmt.Errorf("%w: missing version byte", ErrInvalidAddress)
+	}
+
+	payload = make([]byte, len(converted)-1)
+	for i, v := range converted[1:] {
+		payload[i] = byte(v)
+	}
+
+	return prefix, byte(converted[0]), payload, nil
+}
+
+// cashAddrPrefixExpand returns the lower 5 bits of each prefix character followed by a zero separator
+func cashAddrPrefixExpand(prefix string) []int {
+	prefixData := make([]int, len(prefix)+1)
+	for i, c := range prefix {
+		prefixData[i] = int(c) & 0x1f
+	}
+	prefixData[len(prefix)] = 0
+	return prefixData
+}
+
+// cashAddrChecksum calculates the CashAddr checksum
+func cashAddrChecksum(prefix string, data []int) []int {
+	// Combine prefix and data, add 8 zeros for checksum
+	values := append(cashAddrPrefixExpand(prefix), data...)
+	values = append(values, make([]int, cashAddrChecksumLength)...)
+
+	// Calculate polymod
+	polymod := cashAddrPolymod(values) ^ 1
+
+	// Extract checksum
+	checksum := make([]int, cashAddrChecksumLength)
+	for i := 0; i < cashAddrChecksumLength; i++ {
+		checksum[i] = (polymod >> uint(5*(7-i))) & 0x1f
+	}
+
+	return checksum
+}
+
+// cashAddrPolymod calculates the BCH polymod
+func cashAddrPolymod(values []int) int {
+	generator := []int{
+		0x98f2bc8e61,
+		0x79b76d99e2,
+		0xf33e5fb3c4,
+		0xae2eabe2a8,
+		0x1e4f43e470,
+	}
+
+	chk := 1
+	for _, v := range values {
+		top := chk >> 35
+		chk = ((chk & 0x07ffffffff) << 5) ^ v
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
@@ -0,0 +1,165 @@
+package address
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/study/crypto-accounts/pkgs/crypto/hash"
+)
+
+// Nervos CKB address constants (RFC 0021)
+const (
+	CKBMainnetHRP = "ckb"
+	CKBTestnetHRP = "ckt"
+
+	// Payload format types
+	CKBFormatFull byte = 0x00
+
+	// Script hash types
+	CKBHashTypeData  byte = 0x00
+	CKBHashTypeType  byte = 0x01
+	CKBHashTypeData1 byte = 0x02
+	CKBHashTypeData2 byte = 0x04
+)
+
+// CKBSecp256k1Blake160CodeHash is the code hash of the default secp256k1-blake160 lock script
+var CKBSecp256k1Blake160CodeHash = []byte{
+	0x9b, 0xd7, 0xe0, 0x6f, 0x3e, 0xcf, 0x4b, 0xe0, 0xf2, 0xfc, 0xd2, 0x18, 0x8b, 0x23, 0xf1, 0xb9,
+	0xfc, 0xc8, 0x8e, 0x5d, 0x4b, 0x65, 0xa8, 0x63, 0x7b, 0x17, 0x72, 0x3b, 0xbd, 0xa3, 0xcc, 0xe8,
+}
+
+// ckbPersonalization is the BLAKE2b personalization used by CKB's default hash
+var ckbPersonalization = []byte("ckb-default-hash")
+
+// CKBAddress generates Nervos CKB full-format addresses
+type CKBAddress struct {
+	testnet bool
+}
+
+// NewCKBAddress creates a new CKB address generator for mainnet
+func NewCKBAddress() *CKBAddress {
+	return &CKBAddress{testnet: false}
+}
+
+// NewCKBTestnetAddress creates a new CKB address generator for testnet
+func NewCKBTestnetAddress() *CKBAddress {
+	return &CKBAddress{testnet: true}
+}
+
+func init() {
+	registerChain(ChainCKB,
+		func() AddressGenerator { return NewCKBAddress() },
+		func() AddressGenerator { return NewCKBTestnetAddress() })
+}
+
+// ChainID returns the chain identifier
+func (c *CKBAddress) ChainID() ChainID {
+	return ChainCKB
+}
+
+// Info describes the chain
+func (c *CKBAddress) Info() ChainInfo {
+	return ChainInfo{ChainCKB, "Nervos CKB", "CKB", "Bech32m", "Full-format lock script, starts with 'ckb1'"}
+}
+
+// hrp returns the human-readable prefix for the configured network
+func (c *CKBAddress) hrp() string {
+	if c.testnet {
+		return CKBTestnetHRP
+	}
+	return CKBMainnetHRP
+}
+
+// Generate creates a CKB address for the secp256k1-blake160 lock from a public key
+// Public key should be 33 bytes (compressed secp256k1)
+func (c *CKBAddress) Generate(publicKey []byte) (string, error) {
+	if len(publicKey) != 33 {
+		return "", keyLengthError(c.ChainID(), len(publicKey), 33)
+	}
+
+	// Lock args are the first 20 bytes of the CKB BLAKE2b-256 hash (blake160)
+	args := ckbHash(publicKey)[:20]
+
+	return c.GenerateFromScript(CKBSecp256k1Blake160CodeHash, CKBHashTypeType, args)
+}
+
+// GenerateFromScript creates a full-format CKB address for an arbitrary lock script
+func (c *CKBAddress) GenerateFromScript(codeHash []byte, hashType byte, args []byte) (string, error) {
+	if len(codeHash) != 32 {
+		return "", fmt.Errorf("CKB code hash must be 32 bytes, got %d", len(codeHash))
+	}
+
+	// Payload: format type || code hash || hash type || args
+	payload := make([]byte, 0, 1+32+1+len(args))
+	payload = append(payload, CKBFormatFull)
+	payload = append(payload, codeHash...)
+	payload = append(payload, hashType)
+	payload = append(payload, args...)
+
+	return Bech32Encode(c.hrp(), payload, Bech32m)
+}
+
+// Validate checks if a CKB full-format address is valid
+func (c *CKBAddress) Validate(address string) bool {
+	hrp, data, encoding, err := Bech32Decode(address)
+	if err != nil {
+		return false
+	}
+
+	if hrp != c.hrp() || encoding != Bech32m {
+		return false
+	}
+
+	// Format type + 32-byte code hash + hash type
+	if len(data) < 34 || data[0] != CKBFormatFull {
+		return false
+	}
+
+	switch data[33] {
+	case CKBHashTypeData, CKBHashTypeType, CKBHashTypeData1, CKBHashTypeData2:
+		return true
+	default:
+		return false
+	}
+}
+
+// DecodeAddress decodes a CKB address
+// For the secp256k1-blake160 lock, PublicKey holds the 20-byte blake160 args.
+func (c *CKBAddress) DecodeAddress(address string) (*AddressInfo, error) {
+	if !c.Validate(address) {
+		return nil, ErrInvalidAddress
+	}
+
+	_, data, _, err := Bech32Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AddressInfo{
+		Address:   address,
+		PublicKey: data[34:], // Lock script args
+		ChainID:   ChainCKB,
+		Type:      AddressTypeBech32,
+		Version:   data[0],
+	}, nil
+}
+
+// IsSecp256k1Blake160 reports whether the address uses the default secp256k1-blake160 lock
+func (c *CKBAddress) IsSecp256k1Blake160(address string) bool {
+	if !c.Validate(address) {
+		return false
+	}
+
+	_, data, _, err := Bech32Decode(address)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(data[1:33], CKBSecp256k1Blake160CodeHash) &&
+		data[33] == CKBHashTypeType && len(data) == 34+20
+}
+
+// ckbHash computes CKB's default hash: BLAKE2b-256 with personalization "ckb-default-hash"
+func ckbHash(data []byte) []byte {
+	return hash.Blake2b256Personal(data, ckbPersonalization)
+}
@@ -0,0 +1,38 @@
+package address
+
+import (
+	"github.com/study/crypto-accounts/pkgs/bip32"
+	"github.com/study/crypto-accounts/pkgs/bip39"
+)
+
+// EthereumFromMnemonic derives the Ethereum address and private key at
+// m/44'/60'/account'/0/index from a BIP-39 mnemonic, as MetaMask and most
+// Ethereum wallets do.
+func EthereumFromMnemonic(mnemonic, passphrase string, account, index uint32) (addr string, privKey []byte, err error) {
+	if !bip39.ValidateMnemonic(mnemonic) {
+		return "", nil, bip39.ErrInvalidMnemonic
+	}
+
+	master, err := bip32.NewMasterKey(bip39.NewSeed(mnemonic, passphrase))
+	if err != nil {
+		return "", nil, err
+	}
+
+	key, err := master.DeriveFromPath(bip32.DerivationPath{
+		bip32.Hardened(44),
+		bip32.Hardened(ChainCoinTypes[ChainEthereum]),
+		bip32.Hardened(account),
+		0,
+		index,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	addr, err = NewEthereumAddress().Generate(key.PublicKeyBytes())
+	if err != nil {
+		return "", nil, err
+	}
+
+	return addr, key.PrivateKeyBytes(), nil
+}
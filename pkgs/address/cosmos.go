@@ -47,11 +47,34 @@ func NewSeiAddress() *CosmosAddress {
 	return &CosmosAddress{hrp: SeiHRP, chainID: ChainSei}
 }
 
+func init() {
+	registerChain(ChainCosmos, func() AddressGenerator { return NewCosmosAddress() }, nil)
+	registerChain(ChainBinanceBEP2, func() AddressGenerator { return NewBinanceBEP2Address() }, nil)
+	registerChain(ChainSei, func() AddressGenerator { return NewSeiAddress() }, nil)
+}
+
 // ChainID returns the chain identifier
 func (c *CosmosAddress) ChainID() ChainID {
 	return c.chainID
 }
 
+// cosmosChainInfo describes the chains that share the Cosmos address format
+var cosmosChainInfo = map[ChainID]ChainInfo{
+	ChainCosmos:      {ChainCosmos, "Cosmos", "ATOM", "Bech32", "Starts with 'cosmos'"},
+	ChainBinanceBEP2: {ChainBinanceBEP2, "Binance Chain", "BNB", "Bech32", "Starts with 'bnb'"},
+	ChainSei:         {ChainSei, "Sei", "SEI", "Bech32/Ethereum", "Dual address system"},
+}
+
+// Info describes the chain. Chains outside the table are described by their HRP.
+func (c *CosmosAddress) Info() ChainInfo {
+	if info, ok := cosmosChainInfo[c.chainID]; ok {
+		return info
+	}
+	info := genericChainInfo(c.chainID)
+	info.AddressType, info.Description = "Bech32", fmt.Sprintf("Starts with '%s'", c.hrp)
+	return info
+}
+
 // HRP returns the human-readable prefix
 func (c *CosmosAddress) HRP() string {
 	return c.hrp
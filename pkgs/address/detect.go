@@ -0,0 +1,73 @@
+package address
+
+import (
+	"fmt"
+	"sort"
+)
+
+// HRPToChain maps bech32 human-readable parts to the chain that uses them
+var HRPToChain = map[string]ChainID{
+	BitcoinBech32HRP:         ChainBitcoin,
+	BitcoinTestnetBech32HRP:  ChainBitcoin,
+	LitecoinBech32HRP:        ChainLitecoin,
+	LitecoinTestnetBech32HRP: ChainLitecoin,
+	CosmosHRP:                ChainCosmos,
+	BinanceBEP2HRP:           ChainBinanceBEP2,
+	SeiHRP:                   ChainSei,
+	CardanoMainnetHRP:        ChainCardano,
+	CardanoTestnetHRP:        ChainCardano,
+	CardanoMainnetStakeHRP:   ChainCardano,
+	CardanoTestnetStakeHRP:   ChainCardano,
+	IOTAMainnetHRP:           ChainIOTA,
+	IOTATestnetHRP:           ChainIOTA,
+	ShimmerMainnetHRP:        ChainShimmer,
+	ShimmerTestnetHRP:        ChainShimmer,
+	CKBMainnetHRP:            ChainCKB,
+	CKBTestnetHRP:            ChainCKB,
+	QtumBech32HRP:            ChainQtum,
+	QtumTestnetBech32HRP:     ChainQtum,
+}
+
+// DetectChains returns every registered chain whose validator accepts the address, sorted by ID
+func (f *Factory) DetectChains(addr string) []ChainID {
+	var chains []ChainID
+	for chainID, gen := range f.snapshot() {
+		if gen.Validate(addr) {
+			chains = append(chains, chainID)
+		}
+	}
+
+	sort.Slice(chains, func(i, j int) bool { return chains[i] < chains[j] })
+	return chains
+}
+
+// DetectChain identifies the single chain an address belongs to.
+// Bech32 addresses with a known HRP take a fast path; otherwise every validator
+// is tried and ErrAmbiguousAddress is returned if more than one accepts it.
+func (f *Factory) DetectChain(addr string) (ChainID, error) {
+	if hrp, ok := HRPOf(addr); ok {
+		if chainID, known := HRPToChain[hrp]; known && f.Validate(chainID, addr) {
+			return chainID, nil
+		}
+	}
+
+	chains := f.DetectChains(addr)
+	switch len(chains) {
+	case 0:
+		return "", ErrInvalidAddress
+	case 1:
+		return chains[0], nil
+	default:
+		return "", fmt.Errorf("%w: %v", ErrAmbiguousAddress, chains)
+	}
+}
+
+// DetectChains returns the chains that accept an address using the default factory
+func DetectChains(addr string) []ChainID {
+	return DefaultFactory.DetectChains(addr)
+}
+
+// DetectChain identifies an address's chain using the default factory
+func DetectChain(addr string) (ChainID, error) {
+	return DefaultFactory.DetectChain(addr)
+}
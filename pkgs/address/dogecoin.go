@@ -21,15 +21,26 @@ func NewDogecoinAddress(testnet bool) *DogecoinAddress {
 	return &DogecoinAddress{testnet: testnet}
 }
 
+func init() {
+	registerChain(ChainDogecoin,
+		func() AddressGenerator { return NewDogecoinAddress(false) },
+		func() AddressGenerator { return NewDogecoinAddress(true) })
+}
+
 // ChainID returns the chain identifier
 func (d *DogecoinAddress) ChainID() ChainID {
 	return ChainDogecoin
 }
 
+// Info describes the chain
+func (d *DogecoinAddress) Info() ChainInfo {
+	return ChainInfo{ChainDogecoin, "Dogecoin", "DOGE", "Base58Check", "Starts with 'D'"}
+}
+
 // P2PKH generates a Pay-to-Public-Key-Hash address (starts with D on mainnet)
 func (d *DogecoinAddress) P2PKH(publicKey []byte) (string, error) {
 	if len(publicKey) != 33 && len(publicKey) != 65 {
-		return "", ErrInvalidPublicKey
+		return "", keyLengthError(d.ChainID(), len(publicKey), 33, 65)
 	}
 
 	pubKeyHash := Hash160(publicKey)
@@ -0,0 +1,108 @@
+package address
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/study/crypto-accounts/pkgs/crypto/ed25519"
+)
+
+// Ed25519Scheme describes the SLIP-10 derivation path wallets use for an Ed25519 chain.
+// Every level is hardened, since SLIP-10 Ed25519 has no public derivation.
+type Ed25519Scheme struct {
+	CoinType uint32
+	Depth    int // Number of levels below m: 3, 4 or 5
+}
+
+// Ed25519 path depths
+const (
+	// Ed25519DepthAccount is m/44'/coin'/account' (Stellar SEP-0005, NEAR)
+	Ed25519DepthAccount = 3
+	// Ed25519DepthChange is m/44'/coin'/account'/0' (Solana: Phantom, Solflare)
+	Ed25519DepthChange = 4
+	// Ed25519DepthFull is m/44'/coin'/account'/0'/index' (Aptos, Sui, Algorand)
+	Ed25519DepthFull = 5
+)
+
+// Ed25519DerivationScheme returns the path scheme used by reference wallets for a chain.
+// Chains whose wallets do not derive Ed25519 keys by SLIP-10 have no scheme:
+// Polkadot uses sr25519 with substrate junctions, Kadena and Cardano (CIP-1852)
+// use BIP32-Ed25519 and Waves hashes its seed phrase directly.
+func Ed25519DerivationScheme(chainID ChainID) (Ed25519Scheme, error) {
+	switch chainID {
+	case ChainSolana:
+		return Ed25519Scheme{CoinType: 501, Depth: Ed25519DepthChange}, nil
+	case ChainStellar:
+		return Ed25519Scheme{CoinType: 148, Depth: Ed25519DepthAccount}, nil
+	case ChainNEAR:
+		return Ed25519Scheme{CoinType: 397, Depth: Ed25519DepthAccount}, nil
+	case ChainAlgorand:
+		return Ed25519Scheme{CoinType: 283, Depth: Ed25519DepthFull}, nil
+	case ChainAptos:
+		return Ed25519Scheme{CoinType: 637, Depth: Ed25519DepthFull}, nil
+	case ChainSui:
+		return Ed25519Scheme{CoinType: 784, Depth: Ed25519DepthFull}, nil
+	case ChainTezos:
+		return Ed25519Scheme{CoinType: 1729, Depth: Ed25519DepthChange}, nil
+	case ChainICP:
+		return Ed25519Scheme{CoinType: 223, Depth: Ed25519DepthFull}, nil
+	case ChainHedera:
+		return Ed25519Scheme{CoinType: 3030, Depth: Ed25519DepthFull}, nil
+	case ChainIOTA:
+		return Ed25519Scheme{CoinType: 4218, Depth: Ed25519DepthFull}, nil
+	case ChainShimmer:
+		return Ed25519Scheme{CoinType: 4219, Depth: Ed25519DepthFull}, nil
+	default:
+		return Ed25519Scheme{}, fmt.Errorf("%w: no Ed25519 derivation scheme for %s", ErrUnsupportedChain, chainID)
+	}
+}
+
+// EnumeratesAccounts reports whether the scheme has no address index level, so
+// wallets using it enumerate addresses by account instead.
+func (s Ed25519Scheme) EnumeratesAccounts() bool {
+	return s.Depth < Ed25519DepthFull
+}
+
+// Path returns the hardened path for an account and address index. 3- and
+// 4-level schemes have no index level, so index must be zero for them.
+func (s Ed25519Scheme) Path(account, index uint32) ([]uint32, error) {
+	if account >= ed25519.HardenedOffset || index >= ed25519.HardenedOffset {
+		return nil, fmt.Errorf("%w: account %d, index %d exceed the hardened range", ErrInvalidIndex, account, index)
+	}
+	if index != 0 && s.EnumeratesAccounts() {
+		return nil, fmt.Errorf("%w: coin type %d paths have no index level, enumerate accounts instead", ErrInvalidIndex, s.CoinType)
+	}
+
+	switch s.Depth {
+	case Ed25519DepthAccount:
+		return ed25519.HardenedPath(ed25519.Purpose44, s.CoinType, account), nil
+	case Ed25519DepthChange:
+		return ed25519.HardenedPath(ed25519.Purpose44, s.CoinType, account, 0), nil
+	default:
+		return ed25519.HardenedPath(ed25519.Purpose44, s.CoinType, account, 0, index), nil
+	}
+}
+
+// PathString formats the path for an account and address index, e.g. "m/44'/501'/0'/0'"
+func (s Ed25519Scheme) PathString(account, index uint32) (string, error) {
+	path, err := s.Path(account, index)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.WriteString("m")
+	for _, level := range path {
+		fmt.Fprintf(&sb, "/%d'", level&^ed25519.HardenedOffset)
+	}
+	return sb.String(), nil
+}
+
+// DeriveKey derives the key pair for an account and address index from a BIP-39 seed
+func (s Ed25519Scheme) DeriveKey(seed []byte, account, index uint32) (priv, pub []byte, err error) {
+	path, err := s.Path(account, index)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ed25519.DeriveKeyFromPath(seed, path)
+}
@@ -0,0 +1,102 @@
+package address
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/study/crypto-accounts/pkgs/crypto/secp256k1"
+)
+
+// ErrInvalidRecoveryID is returned when a signature V value does not match the chain id
+var ErrInvalidRecoveryID = errors.New("invalid signature recovery id")
+
+// EVMNetworkIDs maps EVM chains to their EIP-155 chain ids
+var EVMNetworkIDs = map[ChainID]uint64{
+	ChainEthereum:        1,
+	ChainOptimism:        10,
+	ChainBSC:             56,
+	ChainEthereumClassic: 61,
+	ChainPolygon:         137,
+	ChainFantom:          250,
+	ChainTheta:           361,
+	ChainZkSync:          324,
+	ChainBase:            8453,
+	ChainArbitrum:        42161,
+	ChainAvalanche:       43114,
+	ChainLinea:           59144,
+	ChainScroll:          534352,
+}
+
+// maxEIP155ChainID is the largest chain id whose V values fit in a uint64
+const maxEIP155ChainID = (math.MaxUint64 - 36) / 2
+
+// EIP155V returns the signature V value for a recovery id on an EIP-155 chain:
+// V = recID + chainID*2 + 35. The recovery id must be 0 or 1, the parity of the
+// signature's R point; ids 2 and 3 have no EIP-155 encoding.
+func EIP155V(recID byte, chainID uint64) (uint64, error) {
+	if recID > 1 {
+		return 0, fmt.Errorf("%w: recovery id %d is not 0 or 1", ErrInvalidRecoveryID, recID)
+	}
+	if chainID > maxEIP155ChainID {
+		return 0, fmt.Errorf("%w: chain id %d is too large", ErrInvalidRecoveryID, chainID)
+	}
+	return uint64(recID) + chainID*2 + 35, nil
+}
+
+// SplitEIP155V recovers the recovery id (0 or 1) from an EIP-155 V value,
+// failing if V was not produced for chainID.
+func SplitEIP155V(v, chainID uint64) (recID byte, err error) {
+	if chainID > maxEIP155ChainID {
+		return 0, fmt.Errorf("%w: chain id %d is too large", ErrInvalidRecoveryID, chainID)
+	}
+	base := chainID*2 + 35
+	if v < base || v > base+1 {
+		return 0, fmt.Errorf("%w: v=%d does not match chain id %d", ErrInvalidRecoveryID, v, chainID)
+	}
+	return byte(v - base), nil
+}
+
+// EIP155V returns the signature V value for a recovery id on this generator's chain
+func (e *EthereumAddress) EIP155V(recID byte) (uint64, error) {
+	networkID, ok := EVMNetworkIDs[e.chainID]
+	if !ok {
+		return 0, fmt.Errorf("%w: no EIP-155 chain id for %s", ErrUnsupportedChain, e.chainID)
+	}
+	return EIP155V(recID, networkID)
+}
+
+// SignEIP155 signs a 32-byte transaction signing hash with secp256k1.Sign and
+// returns the signature with its EIP-155 V value, which binds it to chainID so
+// it cannot be replayed on another chain. The hash must itself commit to the
+// chain id, as the EIP-155 signing payload does.
+func SignEIP155(privKey, hash []byte, chainID uint64) (*secp256k1.Signature, uint64, error) {
+	if chainID > maxEIP155ChainID {
+		return nil, 0, fmt.Errorf("%w: chain id %d is too large", ErrInvalidRecoveryID, chainID)
+	}
+
+	sig, err := secp256k1.Sign(privKey, hash)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Sign does not report R's parity, so find the recovery id that yields our key
+	pubKey := secp256k1.PrivateKeyToPublicKey(privKey)
+	for recID := byte(0); recID <= 1; recID++ {
+		recovered, err := secp256k1.RecoverPublicKey(hash, sig, recID)
+		if err == nil && recovered.Equal(pubKey) {
+			v, err := EIP155V(recID, chainID)
+			return sig, v, err
+		}
+	}
+	return nil, 0, fmt.Errorf("%w: signature recovers to neither parity", ErrInvalidRecoveryID)
+}
+
+// SignEIP155 signs a transaction signing hash for this generator's chain
+func (e *EthereumAddress) SignEIP155(privKey, hash []byte) (*secp256k1.Signature, uint64, error) {
+	networkID, ok := EVMNetworkIDs[e.chainID]
+	if !ok {
+		return nil, 0, fmt.Errorf("%w: no EIP-155 chain id for %s", ErrUnsupportedChain, e.chainID)
+	}
+	return SignEIP155(privKey, hash, networkID)
+}
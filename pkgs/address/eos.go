@@ -1,6 +1,7 @@
 package address
 
 import (
+	"crypto/subtle"
 	"fmt"
 	"strings"
 )
@@ -17,18 +18,27 @@ func NewEOSAddress() *EOSAddress {
 	return &EOSAddress{}
 }
 
+func init() {
+	registerChain(ChainEOS, func() AddressGenerator { return NewEOSAddress() }, nil)
+}
+
 // ChainID returns the chain identifier
 func (e *EOSAddress) ChainID() ChainID {
 	return ChainEOS
 }
 
+// Info describes the chain
+func (e *EOSAddress) Info() ChainInfo {
+	return ChainInfo{ChainEOS, "EOS", "EOS", "Base58/Name", "12-char account names"}
+}
+
 // Generate creates an EOS public key string from a secp256k1 public key
 // Public key should be 33 bytes (compressed)
 // Note: EOS account names are not derived from public keys - they are chosen by users
 // This returns the EOS public key format (EOS + base58 encoded key)
 func (e *EOSAddress) Generate(publicKey []byte) (string, error) {
 	if len(publicKey) != 33 {
-		return "", fmt.Errorf("invalid public key length: expected 33, got %d", len(publicKey))
+		return "", keyLengthError(e.ChainID(), len(publicKey), 33)
 	}
 
 	// EOS public key format: EOS + base58(pubkey + ripemd160(pubkey)[:4])
@@ -104,10 +114,8 @@ func (e *EOSAddress) validatePublicKey(address string) bool {
 	checksum := decoded[33:]
 	expectedChecksum := RIPEMD160Hash(pubkey)[:4]
 
-	for i := 0; i < 4; i++ {
-		if checksum[i] != expectedChecksum[i] {
-			return false
-		}
+	if subtle.ConstantTimeCompare(checksum, expectedChecksum) != 1 {
+		return false
 	}
 
 	return true
@@ -142,10 +150,8 @@ func (e *EOSAddress) validatePubK1Key(address string) bool {
 	checksumInput := append([]byte("K1"), pubkey...)
 	expectedChecksum := RIPEMD160Hash(checksumInput)[:4]
 
-	for i := 0; i < 4; i++ {
-		if checksum[i] != expectedChecksum[i] {
-			return false
-		}
+	if subtle.ConstantTimeCompare(checksum, expectedChecksum) != 1 {
+		return false
 	}
 
 	return true
@@ -203,7 +209,7 @@ func (e *EOSAddress) DecodeAddress(address string) (*AddressInfo, error) {
 // GeneratePubK1Key creates an EOS public key in PUB_K1 format
 func (e *EOSAddress) GeneratePubK1Key(publicKey []byte) (string, error) {
 	if len(publicKey) != 33 {
-		return "", fmt.Errorf("invalid public key length: expected 33, got %d", len(publicKey))
+		return "", keyLengthError(e.ChainID(), len(publicKey), 33)
 	}
 
 	// Checksum is ripemd160("K1" + pubkey)[:4]
@@ -7,7 +7,7 @@ import (
 )
 
 // EthereumAddress generates Ethereum-style addresses
-// Also used by: BSC, Polygon, Fantom, Optimism, Arbitrum, VeChain, Theta, etc.
+// Also used by: BSC, Polygon, Fantom, Optimism, Arbitrum, Base, zkSync, VeChain, Theta, etc.
 type EthereumAddress struct {
 	chainID ChainID
 }
@@ -22,17 +22,65 @@ func NewEVMAddress(chainID ChainID) *EthereumAddress {
 	return &EthereumAddress{chainID: chainID}
 }
 
+// evmConstructor returns a constructor for an EVM chain's generator
+func evmConstructor(chainID ChainID) GeneratorConstructor {
+	return func() AddressGenerator { return NewEVMAddress(chainID) }
+}
+
+func init() {
+	registerChain(ChainEthereum, func() AddressGenerator { return NewEthereumAddress() }, nil)
+	for _, chainID := range []ChainID{
+		ChainBSC, ChainPolygon, ChainFantom, ChainOptimism, ChainArbitrum, ChainBase, ChainZkSync,
+		ChainLinea, ChainScroll, ChainVeChain, ChainTheta, ChainEthereumClassic,
+	} {
+		registerChain(chainID, evmConstructor(chainID), nil)
+	}
+}
+
 // ChainID returns the chain identifier
 func (e *EthereumAddress) ChainID() ChainID {
 	return e.chainID
 }
 
+// evmChainInfo describes the chains that share the Ethereum address format
+var evmChainInfo = map[ChainID]ChainInfo{
+	ChainEthereum:        {ChainEthereum, "Ethereum", "ETH", "Keccak256", "EIP-55 checksummed addresses"},
+	ChainBSC:             {ChainBSC, "BNB Smart Chain", "BNB", "Keccak256", "Same as Ethereum"},
+	ChainPolygon:         {ChainPolygon, "Polygon", "MATIC", "Keccak256", "Same as Ethereum"},
+	ChainFantom:          {ChainFantom, "Fantom", "FTM", "Keccak256", "Same as Ethereum"},
+	ChainOptimism:        {ChainOptimism, "Optimism", "OP", "Keccak256", "Same as Ethereum"},
+	ChainArbitrum:        {ChainArbitrum, "Arbitrum", "ARB", "Keccak256", "Same as Ethereum"},
+	ChainBase:            {ChainBase, "Base", "ETH", "Keccak256", "Same as Ethereum"},
+	ChainZkSync:          {ChainZkSync, "zkSync Era", "ETH", "Keccak256", "Same as Ethereum"},
+	ChainLinea:           {ChainLinea, "Linea", "ETH", "Keccak256", "Same as Ethereum"},
+	ChainScroll:          {ChainScroll, "Scroll", "ETH", "Keccak256", "Same as Ethereum"},
+	ChainVeChain:         {ChainVeChain, "VeChain", "VET", "Keccak256", "Same as Ethereum"},
+	ChainTheta:           {ChainTheta, "Theta", "THETA", "Keccak256", "Same as Ethereum"},
+	ChainEthereumClassic: {ChainEthereumClassic, "Ethereum Classic", "ETC", "Keccak256", "Same as Ethereum"},
+	ChainAvalanche:       {ChainAvalanche, "Avalanche", "AVAX", "Bech32/Ethereum", "X/P-Chain: Bech32, C-Chain: Ethereum"},
+}
+
+// Info describes the chain. EVM chains outside the table get a generic description.
+func (e *EthereumAddress) Info() ChainInfo {
+	if info, ok := evmChainInfo[e.chainID]; ok {
+		return info
+	}
+	info := genericChainInfo(e.chainID)
+	info.AddressType, info.Description = "Keccak256", "Same as Ethereum"
+	return info
+}
+
 // Generate creates an Ethereum address from a public key
-// Public key should be 64 bytes (uncompressed without 0x04 prefix)
-// or 65 bytes (uncompressed with 0x04 prefix)
+// Public key can be 33 bytes (compressed, decompressed internally),
+// 64 bytes (uncompressed without 0x04 prefix) or 65 bytes (uncompressed with 0x04 prefix)
 func (e *EthereumAddress) Generate(publicKey []byte) (string, error) {
 	var key []byte
 
+	publicKey, err := uncompressedKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+
 	switch len(publicKey) {
 	case 64:
 		// Already uncompressed without prefix
@@ -43,11 +91,8 @@ func (e *EthereumAddress) Generate(publicKey []byte) (string, error) {
 			return "", fmt.Errorf("invalid uncompressed public key prefix")
 		}
 		key = publicKey[1:]
-	case 33:
-		// Compressed public key - need to decompress
-		return "", fmt.Errorf("compressed public keys not supported, please decompress first")
 	default:
-		return "", ErrInvalidPublicKey
+		return "", keyLengthError(e.ChainID(), len(publicKey), 33, 64, 65)
 	}
 
 	// Keccak-256 hash of the public key
@@ -133,6 +178,22 @@ func (e *EthereumAddress) ValidateChecksum(address string) bool {
 	return address == checksummed
 }
 
+// ValidateStrict validates an address, requiring a correct EIP-55 checksum when
+// the address is mixed-case. All-lowercase and all-uppercase addresses carry no
+// checksum and are accepted.
+func (e *EthereumAddress) ValidateStrict(address string) bool {
+	if !e.Validate(address) {
+		return false
+	}
+
+	hexPart := address[2:]
+	if hexPart == strings.ToLower(hexPart) || hexPart == strings.ToUpper(hexPart) {
+		return true
+	}
+
+	return e.ValidateChecksum("0x" + hexPart)
+}
+
 // FromPrivateKey generates an address from a private key
 // This requires secp256k1 public key derivation
 func (e *EthereumAddress) FromPrivateKey(privateKey []byte) (string, error) {
@@ -173,6 +234,10 @@ func EVMChains() map[ChainID]*EthereumAddress {
 		ChainFantom:          NewEVMAddress(ChainFantom),
 		ChainOptimism:        NewEVMAddress(ChainOptimism),
 		ChainArbitrum:        NewEVMAddress(ChainArbitrum),
+		ChainBase:            NewEVMAddress(ChainBase),
+		ChainZkSync:          NewEVMAddress(ChainZkSync),
+		ChainLinea:           NewEVMAddress(ChainLinea),
+		ChainScroll:          NewEVMAddress(ChainScroll),
 		ChainVeChain:         NewEVMAddress(ChainVeChain),
 		ChainTheta:           NewEVMAddress(ChainTheta),
 		ChainEthereumClassic: NewEVMAddress(ChainEthereumClassic),
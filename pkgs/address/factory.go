@@ -2,89 +2,169 @@ package address
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Network identifies which network a factory's generators target
+type Network string
+
+const (
+	NetworkMainnet Network = "mainnet"
+	NetworkTestnet Network = "testnet"
 )
 
 // Factory provides a unified interface to create address generators for different chains
 type Factory struct {
+	mu         sync.RWMutex // Guards generators
 	generators map[ChainID]AddressGenerator
+	network    Network
+	cache      *validationCache // Set by WithCache
 }
 
 // NewFactory creates a new address generator factory
 func NewFactory() *Factory {
+	generatorRegistry.RLock()
+	defer generatorRegistry.RUnlock()
+	return newFactory(NetworkMainnet)
+}
+
+// NewTestnetFactory creates a factory whose generators target testnets.
+// Chains without a distinct testnet address format use their mainnet generator.
+func NewTestnetFactory() *Factory {
+	generatorRegistry.RLock()
+	defer generatorRegistry.RUnlock()
+	return newFactory(NetworkTestnet)
+}
+
+// newFactory creates a factory with a generator for every registered chain.
+// The caller must hold generatorRegistry's lock.
+func newFactory(network Network) *Factory {
 	f := &Factory{
-		generators: make(map[ChainID]AddressGenerator),
+		generators: make(map[ChainID]AddressGenerator, len(generatorRegistry.mainnet)),
+		network:    network,
+	}
+	for chainID, constructor := range generatorRegistry.mainnet {
+		if testnet, ok := generatorRegistry.testnet[chainID]; ok && network == NetworkTestnet {
+			constructor = testnet
+		}
+		f.generators[chainID] = constructor()
 	}
-	f.registerDefaults()
 	return f
 }
 
-// registerDefaults registers all default address generators
-func (f *Factory) registerDefaults() {
-	// Bitcoin-family
-	f.Register(ChainBitcoin, NewBitcoinAddress(false))
-	f.Register(ChainLitecoin, NewLitecoinAddress(false))
-	f.Register(ChainDogecoin, NewDogecoinAddress(false))
-	f.Register(ChainBitcoinCash, NewBitcoinCashAddress(false))
-
-	// Ethereum-family (EVM)
-	f.Register(ChainEthereum, NewEthereumAddress())
-	f.Register(ChainBSC, NewEVMAddress(ChainBSC))
-	f.Register(ChainPolygon, NewEVMAddress(ChainPolygon))
-	f.Register(ChainFantom, NewEVMAddress(ChainFantom))
-	f.Register(ChainOptimism, NewEVMAddress(ChainOptimism))
-	f.Register(ChainArbitrum, NewEVMAddress(ChainArbitrum))
-	f.Register(ChainVeChain, NewEVMAddress(ChainVeChain))
-	f.Register(ChainTheta, NewEVMAddress(ChainTheta))
-	f.Register(ChainEthereumClassic, NewEVMAddress(ChainEthereumClassic))
-	f.Register(ChainAvalanche, NewAvalancheCChainAddress()) // C-Chain is EVM
-
-	// Cosmos-family (Bech32)
-	f.Register(ChainCosmos, NewCosmosAddress())
-	f.Register(ChainBinanceBEP2, NewBinanceBEP2Address())
-	f.Register(ChainSei, NewSeiAddress())
-
-	// TRON
-	f.Register(ChainTron, NewTronAddress(false))
-
-	// Ripple
-	f.Register(ChainRipple, NewRippleAddress())
-
-	// Ed25519-based
-	f.Register(ChainSolana, NewSolanaAddress())
-	f.Register(ChainStellar, NewStellarAddress())
-	f.Register(ChainAlgorand, NewAlgorandAddress())
-	f.Register(ChainNEAR, NewNEARAddress())
-	f.Register(ChainCardano, NewCardanoAddress())
-
-	// Polkadot-family (SS58)
-	f.Register(ChainPolkadot, NewPolkadotAddress())
-
-	// New generation chains
-	f.Register(ChainAptos, NewAptosAddress())
-	f.Register(ChainSui, NewSuiAddress())
-
-	// Other chains
-	f.Register(ChainTezos, NewTezosAddress())
-	f.Register(ChainZcash, NewZcashAddress())
-	f.Register(ChainKaspa, NewKaspaAddress())
-	f.Register(ChainStacks, NewStacksAddress())
-	f.Register(ChainFilecoin, NewFilecoinAddress())
-	f.Register(ChainHedera, NewHederaAddress())
-	f.Register(ChainICP, NewICPAddress())
-	f.Register(ChainEOS, NewEOSAddress())
-	f.Register(ChainFlow, NewFlowAddress())
-	f.Register(ChainArweave, NewArweaveAddress())
-	f.Register(ChainMonero, NewMoneroAddress())
+// Network returns the network this factory's generators target
+func (f *Factory) Network() Network {
+	return f.network
+}
+
+// counterpart returns a factory for the opposite network
+func (f *Factory) counterpart() *Factory {
+	if f.network == NetworkTestnet {
+		return DefaultFactory
+	}
+
+	generatorRegistry.Lock()
+	defer generatorRegistry.Unlock()
+	if generatorRegistry.testnetFactory == nil {
+		generatorRegistry.testnetFactory = newFactory(NetworkTestnet)
+	}
+	return generatorRegistry.testnetFactory
+}
+
+// NetworkOf reports which network an address belongs to.
+// The factory's own network is preferred, so chains whose addresses don't encode
+// a network (e.g. EVM chains) report the factory's network.
+func (f *Factory) NetworkOf(chainID ChainID, addr string) (Network, error) {
+	if _, err := f.Get(chainID); err != nil {
+		return "", err
+	}
+
+	if f.Validate(chainID, addr) {
+		return f.network, nil
+	}
+
+	other := f.counterpart()
+	if other.Validate(chainID, addr) {
+		return other.network, nil
+	}
+
+	return "", ErrInvalidAddress
+}
+
+// GeneratorConstructor creates a fresh address generator for a chain
+type GeneratorConstructor func() AddressGenerator
+
+// generatorRegistry holds the constructor of every chain a new factory
+// registers. Built-in chains add themselves from their own files' init
+// functions, others via RegisterGenerator.
+var generatorRegistry = struct {
+	sync.RWMutex
+	mainnet        map[ChainID]GeneratorConstructor
+	testnet        map[ChainID]GeneratorConstructor // Chains with a distinct testnet format
+	testnetFactory *Factory                         // DefaultFactory's counterpart, once NetworkOf needs it
+}{
+	mainnet: make(map[ChainID]GeneratorConstructor),
+	testnet: make(map[ChainID]GeneratorConstructor),
+}
+
+// registerChain registers a built-in chain. testnet is nil for chains whose
+// addresses don't encode a network.
+func registerChain(chainID ChainID, mainnet, testnet GeneratorConstructor) {
+	generatorRegistry.Lock()
+	defer generatorRegistry.Unlock()
+
+	generatorRegistry.mainnet[chainID] = mainnet
+	if testnet == nil {
+		delete(generatorRegistry.testnet, chainID)
+		testnet = mainnet
+	} else {
+		generatorRegistry.testnet[chainID] = testnet
+	}
+
+	DefaultFactory.Register(chainID, mainnet())
+	if generatorRegistry.testnetFactory != nil {
+		generatorRegistry.testnetFactory.Register(chainID, testnet())
+	}
+}
+
+// RegisterGenerator adds a chain to DefaultFactory and every factory created
+// from now on, replacing any existing registration. The generator serves both
+// networks: registering a built-in chain also replaces its testnet generator.
+// Factories created earlier are not affected.
+func RegisterGenerator(chainID ChainID, constructor GeneratorConstructor) {
+	registerChain(chainID, constructor, nil)
 }
 
 // Register adds a new address generator to the factory
 func (f *Factory) Register(chainID ChainID, generator AddressGenerator) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
 	f.generators[chainID] = generator
+	if f.cache != nil {
+		f.cache.clear()
+	}
+}
+
+// snapshot returns a copy of the registered generators
+func (f *Factory) snapshot() map[ChainID]AddressGenerator {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	generators := make(map[ChainID]AddressGenerator, len(f.generators))
+	for chainID, gen := range f.generators {
+		generators[chainID] = gen
+	}
+	return generators
 }
 
 // Get returns an address generator for the specified chain
 func (f *Factory) Get(chainID ChainID) (AddressGenerator, error) {
+	f.mu.RLock()
 	gen, ok := f.generators[chainID]
+	f.mu.RUnlock()
 	if !ok {
 		return nil, fmt.Errorf("%w: %s", ErrUnsupportedChain, chainID)
 	}
@@ -102,15 +182,57 @@ func (f *Factory) Generate(chainID ChainID, publicKey []byte) (string, error) {
 
 // Validate checks if an address is valid for the specified chain
 func (f *Factory) Validate(chainID ChainID, address string) bool {
+	// Read the generator and cache generation together, so a result from a
+	// generator replaced meanwhile by Register is not cached
+	f.mu.RLock()
+	gen, ok := f.generators[chainID]
+	var generation uint64
+	if f.cache != nil {
+		generation = f.cache.currentGeneration()
+	}
+	f.mu.RUnlock()
+
+	if !ok {
+		return false
+	}
+	if f.cache == nil || len(address) > maxCachedAddressLen {
+		return gen.Validate(address)
+	}
+
+	key := validationKey{chainID, address}
+	if valid, ok := f.cache.get(key); ok {
+		return valid
+	}
+	valid := gen.Validate(address)
+	f.cache.put(key, valid, generation)
+	return valid
+}
+
+// PublicKeyHash returns the public key hash an address commits to, e.g. the
+// Hash160 of a Bitcoin P2PKH address or the 20 bytes of an Ethereum address.
+// Chains whose addresses hold the key itself, such as Solana, return the key.
+func (f *Factory) PublicKeyHash(chainID ChainID, address string) ([]byte, error) {
 	gen, err := f.Get(chainID)
 	if err != nil {
-		return false
+		return nil, err
+	}
+
+	decoder, ok := gen.(AddressDecoder)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s addresses cannot be decoded", ErrUnsupportedChain, chainID)
+	}
+	info, err := decoder.DecodeAddress(address)
+	if err != nil {
+		return nil, err
 	}
-	return gen.Validate(address)
+	return info.PublicKey, nil
 }
 
 // ListSupportedChains returns all supported chain IDs
 func (f *Factory) ListSupportedChains() []ChainID {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
 	chains := make([]ChainID, 0, len(f.generators))
 	for chainID := range f.generators {
 		chains = append(chains, chainID)
@@ -127,79 +249,70 @@ type ChainInfo struct {
 	Description string
 }
 
-// GetChainInfo returns information about a chain
+// ChainInfoProvider is implemented by generators that describe their own chain.
+// Every built-in generator implements it; others are named after their chain ID.
+type ChainInfoProvider interface {
+	Info() ChainInfo
+}
+
+// AddressDecoder is implemented by generators that can decode their addresses
+type AddressDecoder interface {
+	DecodeAddress(address string) (*AddressInfo, error)
+}
+
+// genericChainInfo names a chain after its ID, for generators that don't describe it
+func genericChainInfo(chainID ChainID) ChainInfo {
+	name := strings.ToUpper(string(chainID))
+	return ChainInfo{ID: chainID, Name: name, Symbol: name}
+}
+
+// GetChainInfo returns information about a chain registered in the default factory
 func GetChainInfo(chainID ChainID) *ChainInfo {
-	chainInfoMap := map[ChainID]*ChainInfo{
-		ChainBitcoin:         {ChainBitcoin, "Bitcoin", "BTC", "Base58Check/Bech32", "P2PKH, P2SH, SegWit addresses"},
-		ChainEthereum:        {ChainEthereum, "Ethereum", "ETH", "Keccak256", "EIP-55 checksummed addresses"},
-		ChainLitecoin:        {ChainLitecoin, "Litecoin", "LTC", "Base58Check/Bech32", "Similar to Bitcoin with different prefixes"},
-		ChainDogecoin:        {ChainDogecoin, "Dogecoin", "DOGE", "Base58Check", "Starts with 'D'"},
-		ChainRipple:          {ChainRipple, "Ripple", "XRP", "Base58 (Ripple)", "Starts with 'r'"},
-		ChainStellar:         {ChainStellar, "Stellar", "XLM", "Base32", "Starts with 'G'"},
-		ChainCardano:         {ChainCardano, "Cardano", "ADA", "Bech32", "Starts with 'addr1'"},
-		ChainPolkadot:        {ChainPolkadot, "Polkadot", "DOT", "SS58", "Network-specific prefixes"},
-		ChainSolana:          {ChainSolana, "Solana", "SOL", "Base58", "32-byte public key"},
-		ChainAvalanche:       {ChainAvalanche, "Avalanche", "AVAX", "Bech32/Ethereum", "X/P-Chain: Bech32, C-Chain: Ethereum"},
-		ChainCosmos:          {ChainCosmos, "Cosmos", "ATOM", "Bech32", "Starts with 'cosmos'"},
-		ChainTron:            {ChainTron, "TRON", "TRX", "Base58Check", "Starts with 'T'"},
-		ChainTezos:           {ChainTezos, "Tezos", "XTZ", "Base58Check", "Starts with 'tz'"},
-		ChainMonero:          {ChainMonero, "Monero", "XMR", "Base58", "95 characters, starts with '4'"},
-		ChainBitcoinCash:     {ChainBitcoinCash, "Bitcoin Cash", "BCH", "CashAddr", "Starts with 'bitcoincash:'"},
-		ChainZcash:           {ChainZcash, "Zcash", "ZEC", "Base58Check", "Transparent: 't', Shielded: 'z'"},
-		ChainBSC:             {ChainBSC, "BNB Smart Chain", "BNB", "Keccak256", "Same as Ethereum"},
-		ChainPolygon:         {ChainPolygon, "Polygon", "MATIC", "Keccak256", "Same as Ethereum"},
-		ChainFantom:          {ChainFantom, "Fantom", "FTM", "Keccak256", "Same as Ethereum"},
-		ChainOptimism:        {ChainOptimism, "Optimism", "OP", "Keccak256", "Same as Ethereum"},
-		ChainArbitrum:        {ChainArbitrum, "Arbitrum", "ARB", "Keccak256", "Same as Ethereum"},
-		ChainVeChain:         {ChainVeChain, "VeChain", "VET", "Keccak256", "Same as Ethereum"},
-		ChainTheta:           {ChainTheta, "Theta", "THETA", "Keccak256", "Same as Ethereum"},
-		ChainBinanceBEP2:     {ChainBinanceBEP2, "Binance Chain", "BNB", "Bech32", "Starts with 'bnb'"},
-		ChainNEAR:            {ChainNEAR, "NEAR Protocol", "NEAR", "Hex/Named", "64 hex chars or named accounts"},
-		ChainAlgorand:        {ChainAlgorand, "Algorand", "ALGO", "Base32", "58 characters"},
-		ChainAptos:           {ChainAptos, "Aptos", "APT", "Hex", "0x-prefixed, 64 hex chars"},
-		ChainSui:             {ChainSui, "Sui", "SUI", "Hex", "0x-prefixed, 64 hex chars"},
-		ChainSei:             {ChainSei, "Sei", "SEI", "Bech32/Ethereum", "Dual address system"},
-		ChainEthereumClassic: {ChainEthereumClassic, "Ethereum Classic", "ETC", "Keccak256", "Same as Ethereum"},
-		ChainKaspa:           {ChainKaspa, "Kaspa", "KAS", "Bech32", "Starts with 'kaspa:'"},
-		ChainStacks:          {ChainStacks, "Stacks", "STX", "c32check", "Starts with 'S'"},
-		ChainFilecoin:        {ChainFilecoin, "Filecoin", "FIL", "Base32", "f1 addresses (secp256k1)"},
-		ChainHedera:          {ChainHedera, "Hedera", "HBAR", "Account ID", "shard.realm.num format"},
-		ChainICP:             {ChainICP, "Internet Computer", "ICP", "Principal ID", "Base32 with CRC32"},
-		ChainEOS:             {ChainEOS, "EOS", "EOS", "Base58/Name", "12-char account names"},
-		ChainFlow:            {ChainFlow, "Flow", "FLOW", "Hex", "0x-prefixed, 16 hex chars"},
-		ChainArweave:         {ChainArweave, "Arweave", "AR", "Base64URL", "43 characters (SHA-256)"},
-	}
-
-	info, ok := chainInfoMap[chainID]
-	if !ok {
+	info, err := DefaultFactory.Info(chainID)
+	if err != nil {
 		return nil
 	}
 	return info
 }
 
-// ListAllChainInfo returns information about all supported chains
-func ListAllChainInfo() []*ChainInfo {
-	chains := []ChainID{
-		ChainBitcoin, ChainEthereum, ChainLitecoin, ChainDogecoin, ChainRipple,
-		ChainStellar, ChainCardano, ChainPolkadot, ChainSolana, ChainAvalanche, ChainCosmos,
-		ChainTron, ChainBitcoinCash, ChainBSC, ChainPolygon, ChainFantom,
-		ChainOptimism, ChainArbitrum, ChainVeChain, ChainTheta, ChainBinanceBEP2,
-		ChainNEAR, ChainAlgorand, ChainAptos, ChainSui, ChainSei, ChainEthereumClassic,
-		ChainTezos, ChainZcash, ChainKaspa, ChainStacks, ChainFilecoin,
-		ChainHedera, ChainICP, ChainEOS, ChainFlow, ChainArweave, ChainMonero,
+// Info returns information about a registered chain, as described by its generator
+func (f *Factory) Info(chainID ChainID) (*ChainInfo, error) {
+	gen, err := f.Get(chainID)
+	if err != nil {
+		return nil, err
 	}
 
+	info := genericChainInfo(chainID)
+	if provider, ok := gen.(ChainInfoProvider); ok {
+		info = provider.Info()
+	}
+	return &info, nil
+}
+
+// ListChainInfo returns information about every registered chain, sorted by chain ID
+func (f *Factory) ListChainInfo() []*ChainInfo {
+	chains := f.ListSupportedChains()
+	sort.Slice(chains, func(i, j int) bool {
+		return chains[i] < chains[j]
+	})
+
 	infos := make([]*ChainInfo, 0, len(chains))
 	for _, chainID := range chains {
-		if info := GetChainInfo(chainID); info != nil {
+		if info, err := f.Info(chainID); err == nil {
 			infos = append(infos, info)
 		}
 	}
 	return infos
 }
 
-// DefaultFactory is the default global factory instance
-var DefaultFactory = NewFactory()
+// ListAllChainInfo returns information about all chains in the default factory
+func ListAllChainInfo() []*ChainInfo {
+	return DefaultFactory.ListChainInfo()
+}
+
+// DefaultFactory is the default global factory instance. Chains register into
+// it as they are added to the registry.
+var DefaultFactory = &Factory{generators: make(map[ChainID]AddressGenerator), network: NetworkMainnet}
 
 // Generate creates an address using the default factory
 func Generate(chainID ChainID, publicKey []byte) (string, error) {
@@ -210,3 +323,8 @@ func Generate(chainID ChainID, publicKey []byte) (string, error) {
 func Validate(chainID ChainID, address string) bool {
 	return DefaultFactory.Validate(chainID, address)
 }
+
+// PublicKeyHash returns an address's public key hash using the default factory
+func PublicKeyHash(chainID ChainID, address string) ([]byte, error) {
+	return DefaultFactory.PublicKeyHash(chainID, address)
+}
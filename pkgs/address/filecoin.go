@@ -1,8 +1,11 @@
 package address
 
 import (
+	"crypto/subtle"
 	"fmt"
+	"strconv"
 
+	"github.com/study/crypto-accounts/pkgs/crypto/encoding"
 	"golang.org/x/crypto/blake2b"
 )
 
@@ -14,9 +17,6 @@ const (
 	FilecoinProtocolBLS       = 3 // BLS addresses (f3)
 )
 
-// Filecoin Base32 alphabet (lowercase, no padding)
-const filecoinBase32Alphabet = "abcdefghijklmnopqrstuvwxyz234567"
-
 // FilecoinAddress generates Filecoin (FIL) addresses
 type FilecoinAddress struct {
 	testnet bool
@@ -32,16 +32,31 @@ func NewFilecoinTestnetAddress() *FilecoinAddress {
 	return &FilecoinAddress{testnet: true}
 }
 
+func init() {
+	registerChain(ChainFilecoin,
+		func() AddressGenerator { return NewFilecoinAddress() },
+		func() AddressGenerator { return NewFilecoinTestnetAddress() })
+}
+
 // ChainID returns the chain identifier
 func (f *FilecoinAddress) ChainID() ChainID {
 	return ChainFilecoin
 }
 
+// Info describes the chain
+func (f *FilecoinAddress) Info() ChainInfo {
+	return ChainInfo{ChainFilecoin, "Filecoin", "FIL", "Base32", "f1 addresses (secp256k1)"}
+}
+
 // Generate creates a Filecoin f1 address from a secp256k1 public key
-// Public key should be 65 bytes (uncompressed)
+// Public key can be 33 bytes (compressed, decompressed internally) or 65 bytes (uncompressed)
 func (f *FilecoinAddress) Generate(publicKey []byte) (string, error) {
+	publicKey, err := uncompressedKey(publicKey)
+	if err != nil {
+		return "", err
+	}
 	if len(publicKey) != 65 {
-		return "", fmt.Errorf("invalid public key length: expected 65 (uncompressed), got %d", len(publicKey))
+		return "", keyLengthError(f.ChainID(), len(publicKey), 33, 65)
 	}
 
 	return f.F1Address(publicKey)
@@ -66,13 +81,54 @@ func (f *FilecoinAddress) F1Address(publicKey []byte) (string, error) {
 	payload := append(hash, checksum...)
 
 	// Encode with base32
-	encoded := filecoinBase32Encode(payload)
+	encoded := encoding.Base32Encode(payload, encoding.Base32LowerAlphabet, false)
 
 	// Add prefix
 	prefix := f.getPrefix()
 	return fmt.Sprintf("%s1%s", prefix, encoded), nil
 }
 
+// IDAddress formats an actor ID as an f0 address, e.g. "f01234"
+func (f *FilecoinAddress) IDAddress(id uint64) string {
+	return fmt.Sprintf("%s%d%d", f.getPrefix(), FilecoinProtocolID, id)
+}
+
+// ParseIDAddress returns the actor ID of an f0 address
+func (f *FilecoinAddress) ParseIDAddress(address string) (uint64, error) {
+	if len(address) < 3 || address[0] != f.getPrefix()[0] || address[1] != '0' {
+		return 0, ErrInvalidAddress
+	}
+
+	// Decimal without leading zeros, so every ID has one string form
+	digits := address[2:]
+	if len(digits) > 1 && digits[0] == '0' {
+		return 0, ErrInvalidAddress
+	}
+	id, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidAddress
+	}
+	return id, nil
+}
+
+// IDAddressBytes returns the binary form of an f0 address used in messages:
+// the protocol byte followed by the actor ID as an unsigned LEB128 varint.
+func IDAddressBytes(id uint64) []byte {
+	return append([]byte{FilecoinProtocolID}, encoding.UvarintLE(id)...)
+}
+
+// IDFromAddressBytes is the inverse of IDAddressBytes
+func IDFromAddressBytes(data []byte) (uint64, error) {
+	if len(data) < 2 || data[0] != FilecoinProtocolID {
+		return 0, ErrInvalidAddress
+	}
+	id, n, err := encoding.DecodeUvarintLE(data[1:])
+	if err != nil || 1+n != len(data) {
+		return 0, ErrInvalidAddress
+	}
+	return id, nil
+}
+
 // getPrefix returns the network prefix
 func (f *FilecoinAddress) getPrefix() string {
 	if f.testnet {
@@ -104,6 +160,12 @@ func (f *FilecoinAddress) Validate(address string) bool {
 		return f.validateF1Address(address)
 	}
 
+	// For f0 addresses (actor IDs)
+	if protocol == '0' {
+		_, err := f.ParseIDAddress(address)
+		return err == nil
+	}
+
 	// For other protocols, just do basic validation
 	return len(address) > 2
 }
@@ -116,7 +178,7 @@ func (f *FilecoinAddress) validateF1Address(address string) bool {
 
 	// Decode the base32 payload
 	encoded := address[2:]
-	decoded, err := filecoinBase32Decode(encoded)
+	decoded, err := encoding.Base32Decode(encoded, encoding.Base32LowerAlphabet)
 	if err != nil {
 		return false
 	}
@@ -135,10 +197,8 @@ func (f *FilecoinAddress) validateF1Address(address string) bool {
 	copy(checksumInput[1:], hash)
 	expectedChecksum := filecoinBlake2b32(checksumInput)
 
-	for i := 0; i < 4; i++ {
-		if checksum[i] != expectedChecksum[i] {
-			return false
-		}
+	if subtle.ConstantTimeCompare(checksum, expectedChecksum) != 1 {
+		return false
 	}
 
 	return true
@@ -176,7 +236,7 @@ func (f *FilecoinAddress) DecodeAddress(address string) (*AddressInfo, error) {
 	}
 
 	encoded := address[2:]
-	decoded, err := filecoinBase32Decode(encoded)
+	decoded, err := encoding.Base32Decode(encoded, encoding.Base32LowerAlphabet)
 	if err != nil {
 		return nil, err
 	}
@@ -209,65 +269,3 @@ func filecoinBlake2b32(data []byte) []byte {
 	h.Write(data)
 	return h.Sum(nil)
 }
-
-// filecoinBase32Encode encodes data to base32 (lowercase, no padding)
-func filecoinBase32Encode(data []byte) string {
-	if len(data) == 0 {
-		return ""
-	}
-
-	result := make([]byte, 0, (len(data)*8+4)/5)
-	var carry uint32
-	var bits uint
-
-	for _, b := range data {
-		carry = (carry << 8) | uint32(b)
-		bits += 8
-
-		for bits >= 5 {
-			bits -= 5
-			result = append(result, filecoinBase32Alphabet[(carry>>bits)&0x1F])
-		}
-	}
-
-	if bits > 0 {
-		result = append(result, filecoinBase32Alphabet[(carry<<(5-bits))&0x1F])
-	}
-
-	return string(result)
-}
-
-// filecoinBase32Decode decodes base32 to bytes
-func filecoinBase32Decode(str string) ([]byte, error) {
-	if len(str) == 0 {
-		return []byte{}, nil
-	}
-
-	// Build reverse lookup
-	alphabet := make(map[byte]byte)
-	for i := 0; i < len(filecoinBase32Alphabet); i++ {
-		alphabet[filecoinBase32Alphabet[i]] = byte(i)
-	}
-
-	var carry uint64
-	var bits uint
-
-	result := make([]byte, 0, len(str)*5/8)
-
-	for i := 0; i < len(str); i++ {
-		val, ok := alphabet[str[i]]
-		if !ok {
-			return nil, fmt.Errorf("invalid character: %c", str[i])
-		}
-
-		carry = (carry << 5) | uint64(val)
-		bits += 5
-
-		for bits >= 8 {
-			bits -= 8
-			result = append(result, byte(carry>>bits))
-		}
-	}
-
-	return result, nil
-}
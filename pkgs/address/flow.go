@@ -2,7 +2,6 @@ package address
 
 import (
 	"encoding/hex"
-	"fmt"
 	"strings"
 )
 
@@ -34,17 +33,28 @@ func NewFlowTestnetAddress() *FlowAddress {
 	return &FlowAddress{testnet: true}
 }
 
+func init() {
+	registerChain(ChainFlow,
+		func() AddressGenerator { return NewFlowAddress() },
+		func() AddressGenerator { return NewFlowTestnetAddress() })
+}
+
 // ChainID returns the chain identifier
 func (f *FlowAddress) ChainID() ChainID {
 	return ChainFlow
 }
 
+// Info describes the chain
+func (f *FlowAddress) Info() ChainInfo {
+	return ChainInfo{ChainFlow, "Flow", "FLOW", "Hex", "0x-prefixed, 16 hex chars"}
+}
+
 // Generate creates a Flow-compatible hex representation of public key hash
 // Note: Flow addresses are NOT derived from public keys directly
 // They are assigned by the network. This generates a hash that can be used as a reference.
 func (f *FlowAddress) Generate(publicKey []byte) (string, error) {
 	if len(publicKey) != 32 && len(publicKey) != 33 && len(publicKey) != 64 && len(publicKey) != 65 {
-		return "", fmt.Errorf("invalid public key length: got %d", len(publicKey))
+		return "", keyLengthError(f.ChainID(), len(publicKey), 32, 33, 64, 65)
 	}
 
 	// Hash the public key to create a pseudo-address
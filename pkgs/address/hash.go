@@ -30,6 +30,18 @@ func SHA256Hash(data []byte) []byte {
 	return hash[:]
 }
 
+// TaggedHash computes the BIP-340 tagged hash SHA256(SHA256(tag) || SHA256(tag) || data)
+func TaggedHash(tag string, data ...[]byte) []byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
+
 // SHA512Hash performs a single SHA512 hash
 func SHA512Hash(data []byte) []byte {
 	hash := sha512.Sum512(data)
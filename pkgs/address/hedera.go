@@ -1,11 +1,20 @@
 package address
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/study/crypto-accounts/pkgs/crypto/secp256k1"
+)
+
+// DER SubjectPublicKeyInfo prefixes Hedera SDKs print before raw public keys
+var (
+	HederaEd25519DERPrefix = []byte{0x30, 0x2a, 0x30, 0x05, 0x06, 0x03, 0x2b, 0x65, 0x70, 0x03, 0x21, 0x00}
+	HederaECDSADERPrefix   = []byte{0x30, 0x2d, 0x30, 0x07, 0x06, 0x05, 0x2b, 0x81, 0x04, 0x00, 0x0a, 0x03, 0x22, 0x00}
 )
 
 // HederaAddress generates Hedera (HBAR) addresses/account IDs
@@ -26,23 +35,60 @@ func NewHederaAddressWithShardRealm(shard, realm uint64) *HederaAddress {
 	return &HederaAddress{shard: shard, realm: realm}
 }
 
+func init() {
+	registerChain(ChainHedera, func() AddressGenerator { return NewHederaAddress() }, nil)
+}
+
 // ChainID returns the chain identifier
 func (h *HederaAddress) ChainID() ChainID {
 	return ChainHedera
 }
 
+// Info describes the chain
+func (h *HederaAddress) Info() ChainInfo {
+	return ChainInfo{ChainHedera, "Hedera", "HBAR", "Account ID", "shard.realm.num format"}
+}
+
 // Generate creates a Hedera alias address from a public key
-// Public key can be 32 bytes (Ed25519) or 33 bytes (ECDSA secp256k1)
-// Returns the hex-encoded public key as an alias
+// Public key can be 32 bytes (Ed25519) or 33 bytes (compressed ECDSA secp256k1),
+// raw or DER-encoded. Ed25519 keys give a key alias (shard.realm.publicKeyHex);
+// ECDSA keys give the EVM address alias from GenerateFromECDSA.
 func (h *HederaAddress) Generate(publicKey []byte) (string, error) {
-	if len(publicKey) != 32 && len(publicKey) != 33 {
-		return "", fmt.Errorf("invalid public key length: expected 32 (Ed25519) or 33 (ECDSA), got %d", len(publicKey))
+	switch {
+	case len(publicKey) == len(HederaEd25519DERPrefix)+32 && bytes.HasPrefix(publicKey, HederaEd25519DERPrefix):
+		publicKey = publicKey[len(HederaEd25519DERPrefix):]
+	case len(publicKey) == len(HederaECDSADERPrefix)+33 && bytes.HasPrefix(publicKey, HederaECDSADERPrefix):
+		publicKey = publicKey[len(HederaECDSADERPrefix):]
+	}
+
+	switch len(publicKey) {
+	case 32:
+		// Hedera supports public key aliases in hex format
+		// Format: shard.realm.publicKeyHex
+		pubKeyHex := hex.EncodeToString(publicKey)
+		return fmt.Sprintf("%d.%d.%s", h.shard, h.realm, pubKeyHex), nil
+	case 33:
+		return h.GenerateFromECDSA(publicKey)
+	default:
+		return "", keyLengthError(h.ChainID(), len(publicKey), 32, 33)
+	}
+}
+
+// GenerateFromECDSA creates the EVM address alias (shard.realm.evmAddressHex)
+// of a compressed secp256k1 public key. The 20-byte EVM address is the last
+// 20 bytes of Keccak-256 over the uncompressed key, as on Ethereum.
+func (h *HederaAddress) GenerateFromECDSA(compressedPubKey []byte) (string, error) {
+	if len(compressedPubKey) != secp256k1.CompressedPubKeyLen {
+		return "", fmt.Errorf("invalid public key length: expected 33 (compressed ECDSA), got %d", len(compressedPubKey))
 	}
 
-	// Hedera supports public key aliases in hex format
-	// Format: shard.realm.publicKeyHex
-	pubKeyHex := hex.EncodeToString(publicKey)
-	return fmt.Sprintf("%d.%d.%s", h.shard, h.realm, pubKeyHex), nil
+	uncompressed, err := uncompressedKey(compressedPubKey)
+	if err != nil {
+		return "", err
+	}
+
+	evmAddress := Keccak256(uncompressed[1:])[12:]
+	return fmt.Sprintf("%d.%d.%s", h.shard, h.realm, hex.EncodeToString(evmAddress)), nil
 }
 
 // GenerateAccountID creates a standard account ID (not from public key)
@@ -63,8 +109,9 @@ func (h *HederaAddress) Validate(address string) bool {
 	aliasPattern := regexp.MustCompile(`^(\d+)\.(\d+)\.([0-9a-fA-F]+)$`)
 	if matches := aliasPattern.FindStringSubmatch(address); matches != nil {
 		hexPart := matches[3]
-		// Public key should be 32 bytes (64 hex) for Ed25519 or 33 bytes (66 hex) for ECDSA
-		if len(hexPart) == 64 || len(hexPart) == 66 {
+		// Public key should be 32 bytes (64 hex) for Ed25519 or 33 bytes (66 hex) for ECDSA,
+		// or a 20-byte (40 hex) EVM address alias
+		if len(hexPart) == 40 || len(hexPart) == 64 || len(hexPart) == 66 {
 			return true
 		}
 	}
@@ -89,6 +136,9 @@ func (h *HederaAddress) GetAddressType(address string) (string, error) {
 	}
 
 	// It's an alias
+	if len(parts[2]) == 40 {
+		return "EVM Address Alias", nil
+	}
 	if len(parts[2]) == 64 {
 		return "Ed25519 Alias", nil
 	}
@@ -112,8 +162,8 @@ func (h *HederaAddress) DecodeAddress(address string) (*AddressInfo, error) {
 
 	var publicKey []byte
 
-	// Check if it's an alias (hex public key)
-	if len(parts[2]) == 64 || len(parts[2]) == 66 {
+	// Check if it's an alias (hex public key or EVM address)
+	if len(parts[2]) == 40 || len(parts[2]) == 64 || len(parts[2]) == 66 {
 		var err error
 		publicKey, err = hex.DecodeString(parts[2])
 		if err != nil {
@@ -123,7 +173,7 @@ func (h *HederaAddress) DecodeAddress(address string) (*AddressInfo, error) {
 
 	return &AddressInfo{
 		Address:   address,
-		PublicKey: publicKey, // May be nil for account IDs
+		PublicKey: publicKey, // EVM address for EVM aliases; nil for account IDs
 		ChainID:   ChainHedera,
 		Type:      AddressTypeBase58, // Using as placeholder
 	}, nil
@@ -3,8 +3,10 @@ package address
 import (
 	"crypto/sha256"
 	"encoding/binary"
-	"fmt"
 	"strings"
+
+	"github.com/study/crypto-accounts/pkgs/crypto/checksum"
+	"github.com/study/crypto-accounts/pkgs/crypto/encoding"
 )
 
 // ICP Principal types
@@ -21,16 +23,25 @@ func NewICPAddress() *ICPAddress {
 	return &ICPAddress{}
 }
 
+func init() {
+	registerChain(ChainICP, func() AddressGenerator { return NewICPAddress() }, nil)
+}
+
 // ChainID returns the chain identifier
 func (i *ICPAddress) ChainID() ChainID {
 	return ChainICP
 }
 
+// Info describes the chain
+func (i *ICPAddress) Info() ChainInfo {
+	return ChainInfo{ChainICP, "Internet Computer", "ICP", "Principal ID", "Base32 with CRC32"}
+}
+
 // Generate creates an ICP Principal ID from a public key
 // Supports Ed25519 (32 bytes) or Secp256k1 (33 bytes compressed)
 func (i *ICPAddress) Generate(publicKey []byte) (string, error) {
 	if len(publicKey) != 32 && len(publicKey) != 33 {
-		return "", fmt.Errorf("invalid public key length: expected 32 (Ed25519) or 33 (Secp256k1), got %d", len(publicKey))
+		return "", keyLengthError(i.ChainID(), len(publicKey), 32, 33)
 	}
 
 	// Create DER-encoded public key representation
@@ -83,7 +94,7 @@ func (i *ICPAddress) wrapSecp256k1PublicKey(publicKey []byte) []byte {
 // encodePrincipal encodes principal bytes to textual representation
 func (i *ICPAddress) encodePrincipal(data []byte) string {
 	// Calculate CRC32 checksum
-	crc := i.crc32(data)
+	crc := checksum.CRC32ISO(data)
 
 	// Prepend checksum to data
 	withChecksum := make([]byte, 4+len(data))
@@ -91,57 +102,12 @@ func (i *ICPAddress) encodePrincipal(data []byte) string {
 	copy(withChecksum[4:], data)
 
 	// Base32 encode (lowercase, no padding)
-	encoded := i.base32Encode(withChecksum)
+	encoded := encoding.Base32Encode(withChecksum, encoding.Base32LowerAlphabet, false)
 
 	// Group into 5-character segments separated by dashes
 	return i.groupWithDashes(encoded, 5)
 }
 
-// crc32 calculates CRC32 checksum for ICP
-func (i *ICPAddress) crc32(data []byte) uint32 {
-	// ICP uses CRC-32 with polynomial 0x04C11DB7 (ISO 3309)
-	var crc uint32 = 0xFFFFFFFF
-	for _, b := range data {
-		crc ^= uint32(b) << 24
-		for j := 0; j < 8; j++ {
-			if crc&0x80000000 != 0 {
-				crc = (crc << 1) ^ 0x04C11DB7
-			} else {
-				crc <<= 1
-			}
-		}
-	}
-	return crc
-}
-
-// base32Encode encodes data to base32 (lowercase, no padding)
-func (i *ICPAddress) base32Encode(data []byte) string {
-	const alphabet = "abcdefghijklmnopqrstuvwxyz234567"
-	if len(data) == 0 {
-		return ""
-	}
-
-	result := make([]byte, 0, (len(data)*8+4)/5)
-	var carry uint64
-	var bits uint
-
-	for _, b := range data {
-		carry = (carry << 8) | uint64(b)
-		bits += 8
-
-		for bits >= 5 {
-			bits -= 5
-			result = append(result, alphabet[(carry>>bits)&0x1F])
-		}
-	}
-
-	if bits > 0 {
-		result = append(result, alphabet[(carry<<(5-bits))&0x1F])
-	}
-
-	return string(result)
-}
-
 // groupWithDashes groups string into segments separated by dashes
 func (i *ICPAddress) groupWithDashes(s string, groupSize int) string {
 	var groups []string
@@ -162,7 +128,7 @@ func (i *ICPAddress) Validate(address string) bool {
 	cleaned := strings.ReplaceAll(address, "-", "")
 
 	// Decode base32
-	decoded, err := i.base32Decode(cleaned)
+	decoded, err := encoding.Base32Decode(cleaned, encoding.Base32LowerAlphabet)
 	if err != nil {
 		return false
 	}
@@ -173,54 +139,18 @@ func (i *ICPAddress) Validate(address string) bool {
 	}
 
 	// Extract checksum and data
-	checksum := binary.BigEndian.Uint32(decoded[:4])
+	crc := binary.BigEndian.Uint32(decoded[:4])
 	data := decoded[4:]
 
 	// Verify checksum
-	expectedChecksum := i.crc32(data)
-	return checksum == expectedChecksum
-}
-
-// base32Decode decodes base32 to bytes
-func (i *ICPAddress) base32Decode(str string) ([]byte, error) {
-	const alphabet = "abcdefghijklmnopqrstuvwxyz234567"
-	if len(str) == 0 {
-		return []byte{}, nil
-	}
-
-	// Build reverse lookup
-	lookup := make(map[byte]byte)
-	for j := 0; j < len(alphabet); j++ {
-		lookup[alphabet[j]] = byte(j)
-	}
-
-	var carry uint64
-	var bits uint
-	result := make([]byte, 0, len(str)*5/8)
-
-	for j := 0; j < len(str); j++ {
-		c := str[j]
-		val, ok := lookup[c]
-		if !ok {
-			return nil, fmt.Errorf("invalid character: %c", c)
-		}
-
-		carry = (carry << 5) | uint64(val)
-		bits += 5
-
-		for bits >= 8 {
-			bits -= 8
-			result = append(result, byte(carry>>bits))
-		}
-	}
-
-	return result, nil
+	expectedChecksum := checksum.CRC32ISO(data)
+	return crc == expectedChecksum
 }
 
 // GetAddressType returns the type of ICP address
 func (i *ICPAddress) GetAddressType(address string) (string, error) {
 	cleaned := strings.ReplaceAll(address, "-", "")
-	decoded, err := i.base32Decode(cleaned)
+	decoded, err := encoding.Base32Decode(cleaned, encoding.Base32LowerAlphabet)
 	if err != nil {
 		return "", ErrInvalidAddress
 	}
@@ -249,7 +179,7 @@ func (i *ICPAddress) DecodeAddress(address string) (*AddressInfo, error) {
 	}
 
 	cleaned := strings.ReplaceAll(address, "-", "")
-	decoded, err := i.base32Decode(cleaned)
+	decoded, err := encoding.Base32Decode(cleaned, encoding.Base32LowerAlphabet)
 	if err != nil {
 		return nil, err
 	}
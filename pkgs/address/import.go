@@ -0,0 +1,208 @@
+package address
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/study/crypto-accounts/pkgs/bip32"
+	"github.com/study/crypto-accounts/pkgs/bip39"
+	"github.com/study/crypto-accounts/pkgs/crypto/encoding"
+	"github.com/study/crypto-accounts/pkgs/crypto/rsa"
+	"github.com/study/crypto-accounts/pkgs/crypto/secp256k1"
+)
+
+// KeyFormat identifies the encoding ImportKey detected
+type KeyFormat string
+
+const (
+	KeyFormatHex         KeyFormat = "hex"      // 32-byte secp256k1 private key in hex
+	KeyFormatWIF         KeyFormat = "wif"      // Bitcoin wallet import format
+	KeyFormatExtendedKey KeyFormat = "extended" // BIP-32 xprv/xpub (or another registered network)
+	KeyFormatMnemonic    KeyFormat = "mnemonic" // BIP-39 English mnemonic
+	KeyFormatJWK         KeyFormat = "jwk"      // RSA JSON Web Key, as Arweave wallets store
+)
+
+// WIF version bytes
+const (
+	wifMainnetPrefix byte = 0x80
+	wifTestnetPrefix byte = 0xef
+)
+
+// ImportedKey is key material normalized by ImportKey
+type ImportedKey struct {
+	Format KeyFormat
+	Curve  Curve // Empty for RSA keys
+
+	// PrivateKey is the secp256k1 scalar, or nil for public-only input and RSA keys
+	PrivateKey []byte
+
+	// PublicKey is the compressed secp256k1 key (uncompressed for an
+	// uncompressed WIF) or, for RSA, the modulus Arweave addresses hash
+	PublicKey []byte
+
+	// Compressed and Testnet report the flags encoded in a WIF
+	Compressed bool
+	Testnet    bool
+
+	// ExtendedKey is the parsed BIP-32 key, or the master key of a mnemonic
+	// derived without a passphrase
+	ExtendedKey *bip32.ExtendedKey
+
+	// JWK is the parsed RSA key of JWK input
+	JWK *rsa.JWK
+}
+
+// ImportKey detects the format of user-supplied key material and parses it:
+// a hex private key (with or without 0x), a WIF, an extended key, a BIP-39
+// mnemonic or an RSA JWK. Hex keys are taken to be secp256k1, as most wallets
+// exporting raw hex keys are. Input that matches no format returns
+// ErrUnknownKeyFormat; input that matches one but is malformed returns that
+// format's error.
+func ImportKey(input string) (*ImportedKey, error) {
+	input = strings.TrimSpace(input)
+
+	switch {
+	case strings.HasPrefix(input, "{"):
+		return importJWK(input)
+	case len(strings.Fields(input)) > 1:
+		return importMnemonic(input)
+	case isHexPrivateKey(input):
+		return importHex(input)
+	}
+
+	decoded, err := encoding.Base58CheckDecode(input)
+	if err != nil {
+		return nil, ErrUnknownKeyFormat
+	}
+	switch len(decoded) {
+	case 33, 34:
+		return importWIF(decoded)
+	case 78:
+		return importExtendedKey(decoded)
+	default:
+		return nil, ErrUnknownKeyFormat
+	}
+}
+
+// isHexPrivateKey reports whether s is 64 hex digits, optionally 0x-prefixed
+func isHexPrivateKey(s string) bool {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	if len(s) != 64 {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
+
+func importHex(input string) (*ImportedKey, error) {
+	privKey, _ := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(input, "0x"), "0X"))
+	if !secp256k1.IsValidPrivateKey(privKey) {
+		return nil, ErrInvalidPrivateKey
+	}
+
+	return &ImportedKey{
+		Format:     KeyFormatHex,
+		Curve:      CurveSecp256k1,
+		PrivateKey: privKey,
+		PublicKey:  secp256k1.PrivateKeyToCompressedPublicKey(privKey),
+		Compressed: true,
+	}, nil
+}
+
+// importWIF parses a decoded WIF: version || key, with a trailing 0x01 for
+// keys whose addresses use the compressed public key
+func importWIF(payload []byte) (*ImportedKey, error) {
+	if payload[0] != wifMainnetPrefix && payload[0] != wifTestnetPrefix {
+		return nil, fmt.Errorf("%w: WIF version 0x%02x", ErrInvalidVersion, payload[0])
+	}
+
+	compressed := len(payload) == 34
+	if compressed && payload[33] != 0x01 {
+		return nil, fmt.Errorf("%w: WIF compression flag 0x%02x", ErrInvalidPrivateKey, payload[33])
+	}
+
+	privKey := append([]byte(nil), payload[1:33]...)
+	if !secp256k1.IsValidPrivateKey(privKey) {
+		return nil, ErrInvalidPrivateKey
+	}
+
+	pubKey := secp256k1.PrivateKeyToPublicKey(privKey)
+	key := &ImportedKey{
+		Format:     KeyFormatWIF,
+		Curve:      CurveSecp256k1,
+		PrivateKey: privKey,
+		PublicKey:  secp256k1.CompressPoint(pubKey),
+		Compressed: compressed,
+		Testnet:    payload[0] == wifTestnetPrefix,
+	}
+	if !compressed {
+		key.PublicKey = secp256k1.SerializeUncompressed(pubKey)
+	}
+	return key, nil
+}
+
+func importExtendedKey(payload []byte) (*ImportedKey, error) {
+	extKey, err := bip32.DeserializeExtendedKey(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &ImportedKey{
+		Format:      KeyFormatExtendedKey,
+		Curve:       CurveSecp256k1,
+		PublicKey:   extKey.PublicKeyBytes(),
+		Compressed:  true,
+		Testnet:     extKey.Network() == bip32.TestNet,
+		ExtendedKey: extKey,
+	}
+	if extKey.IsPrivate() {
+		key.PrivateKey = extKey.PrivateKeyBytes()
+	}
+	return key, nil
+}
+
+func importMnemonic(input string) (*ImportedKey, error) {
+	mnemonic := strings.Join(strings.Fields(strings.ToLower(input)), " ")
+	if _, err := bip39.MnemonicToEntropy(mnemonic); err != nil {
+		return nil, err
+	}
+
+	master, err := bip32.NewMasterKey(bip39.NewSeed(mnemonic, ""))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImportedKey{
+		Format:      KeyFormatMnemonic,
+		Curve:       CurveSecp256k1,
+		PrivateKey:  master.PrivateKeyBytes(),
+		PublicKey:   master.PublicKeyBytes(),
+		Compressed:  true,
+		ExtendedKey: master,
+	}, nil
+}
+
+// importJWK parses an RSA JWK, private or public
+func importJWK(input string) (*ImportedKey, error) {
+	jwk, err := rsa.JWKFromJSON(input)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := jwk.ToPublicKey()
+	if err != nil {
+		return nil, err
+	}
+	if jwk.D != "" {
+		if _, err := jwk.ToPrivateKey(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ImportedKey{
+		Format:    KeyFormatJWK,
+		PublicKey: rsa.GetModulus(pubKey),
+		JWK:       jwk,
+	}, nil
+}
@@ -0,0 +1,126 @@
+package address
+
+import "fmt"
+
+// IOTA Stardust address constants
+const (
+	IOTAEd25519AddressType byte = 0x00 // Ed25519 address
+	IOTAAliasAddressType   byte = 0x08 // Alias address
+	IOTANFTAddressType     byte = 0x10 // NFT address
+
+	IOTAMainnetHRP    = "iota"
+	IOTATestnetHRP    = "atoi"
+	ShimmerMainnetHRP = "smr"
+	ShimmerTestnetHRP = "rms"
+)
+
+// IOTAAddress generates IOTA and Shimmer (Stardust) addresses
+type IOTAAddress struct {
+	hrp     string
+	chainID ChainID
+}
+
+// NewIOTAAddress creates a new IOTA mainnet address generator
+func NewIOTAAddress() *IOTAAddress {
+	return &IOTAAddress{hrp: IOTAMainnetHRP, chainID: ChainIOTA}
+}
+
+// NewShimmerAddress creates a new Shimmer mainnet address generator
+func NewShimmerAddress() *IOTAAddress {
+	return &IOTAAddress{hrp: ShimmerMainnetHRP, chainID: ChainShimmer}
+}
+
+// NewIOTAAddressWithHRP creates an IOTA-style address generator with a custom HRP
+func NewIOTAAddressWithHRP(hrp string, chainID ChainID) *IOTAAddress {
+	return &IOTAAddress{hrp: hrp, chainID: chainID}
+}
+
+func init() {
+	registerChain(ChainIOTA,
+		func() AddressGenerator { return NewIOTAAddress() },
+		func() AddressGenerator { return NewIOTAAddressWithHRP(IOTATestnetHRP, ChainIOTA) })
+	registerChain(ChainShimmer,
+		func() AddressGenerator { return NewShimmerAddress() },
+		func() AddressGenerator { return NewIOTAAddressWithHRP(ShimmerTestnetHRP, ChainShimmer) })
+}
+
+// ChainID returns the chain identifier
+func (i *IOTAAddress) ChainID() ChainID {
+	return i.chainID
+}
+
+// Info describes the chain, with the prefix of the generator's network
+func (i *IOTAAddress) Info() ChainInfo {
+	info := genericChainInfo(i.chainID)
+	switch i.chainID {
+	case ChainIOTA:
+		info.Name, info.Symbol = "IOTA", "IOTA"
+	case ChainShimmer:
+		info.Name, info.Symbol = "Shimmer", "SMR"
+	}
+	info.AddressType, info.Description = "Bech32", fmt.Sprintf("Starts with '%s1'", i.hrp)
+	return info
+}
+
+// HRP returns the human-readable prefix
+func (i *IOTAAddress) HRP() string {
+	return i.hrp
+}
+
+// Generate creates an IOTA Ed25519 address from a public key
+// Public key should be 32 bytes (Ed25519)
+func (i *IOTAAddress) Generate(publicKey []byte) (string, error) {
+	if len(publicKey) != 32 {
+		return "", keyLengthError(i.ChainID(), len(publicKey), 32)
+	}
+
+	// Address = type byte || BLAKE2b-256(publicKey)
+	data := make([]byte, 33)
+	data[0] = IOTAEd25519AddressType
+	copy(data[1:], Blake2b256(publicKey))
+
+	return Bech32Encode(i.hrp, data, Bech32Standard)
+}
+
+// Validate checks if an IOTA address is valid
+func (i *IOTAAddress) Validate(address string) bool {
+	hrp, data, _, err := Bech32Decode(address)
+	if err != nil {
+		return false
+	}
+
+	if hrp != i.hrp {
+		return false
+	}
+
+	if len(data) != 33 {
+		return false
+	}
+
+	switch data[0] {
+	case IOTAEd25519AddressType, IOTAAliasAddressType, IOTANFTAddressType:
+		return true
+	default:
+		return false
+	}
+}
+
+// DecodeAddress decodes an IOTA address
+func (i *IOTAAddress) DecodeAddress(address string) (*AddressInfo, error) {
+	if !i.Validate(address) {
+		return nil, ErrInvalidAddress
+	}
+
+	_, data, _, err := Bech32Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AddressInfo{
+		Address:   address,
+		PublicKey: data[1:], // BLAKE2b-256 hash of the public key (or alias/NFT ID)
+		ChainID:   i.chainID,
+		Type:      AddressTypeBech32,
+		Version:   data[0],
+	}, nil
+}
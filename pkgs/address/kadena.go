@@ -0,0 +1,91 @@
+package address
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// Kadena account prefixes
+const (
+	KadenaKeyPrefix      = "k:" // Single-key account guarded by keys-all
+	KadenaWebAuthnPrefix = "w:" // WebAuthn/principal account
+)
+
+// KadenaAddress generates Kadena (KDA) "k:" principal accounts
+type KadenaAddress struct{}
+
+// NewKadenaAddress creates a new Kadena address generator
+func NewKadenaAddress() *KadenaAddress {
+	return &KadenaAddress{}
+}
+
+func init() {
+	registerChain(ChainKadena, func() AddressGenerator { return NewKadenaAddress() }, nil)
+}
+
+// ChainID returns the chain identifier
+func (k *KadenaAddress) ChainID() ChainID {
+	return ChainKadena
+}
+
+// Info describes the chain
+func (k *KadenaAddress) Info() ChainInfo {
+	return ChainInfo{ChainKadena, "Kadena", "KDA", "Hex", "'k:' + 64 hex chars"}
+}
+
+// Generate creates a Kadena k: account from an Ed25519 public key
+// Public key should be 32 bytes
+func (k *KadenaAddress) Generate(publicKey []byte) (string, error) {
+	if len(publicKey) != 32 {
+		return "", keyLengthError(k.ChainID(), len(publicKey), 32)
+	}
+
+	// k: account is the lowercase hex public key
+	return KadenaKeyPrefix + hex.EncodeToString(publicKey), nil
+}
+
+// Validate checks if a Kadena k: account is valid
+func (k *KadenaAddress) Validate(address string) bool {
+	_, err := k.ParseAccount(address)
+	return err == nil
+}
+
+// ParseAccount returns the raw public key of a k: account
+func (k *KadenaAddress) ParseAccount(address string) ([]byte, error) {
+	if !strings.HasPrefix(address, KadenaKeyPrefix) {
+		return nil, fmt.Errorf("%w: missing %q prefix", ErrInvalidAddress, KadenaKeyPrefix)
+	}
+
+	hexPart := address[len(KadenaKeyPrefix):]
+	if len(hexPart) != 64 {
+		return nil, fmt.Errorf("%w: expected 64 hex characters, got %d", ErrInvalidAddress, len(hexPart))
+	}
+
+	// Kadena keys are canonical lowercase hex
+	if strings.ToLower(hexPart) != hexPart {
+		return nil, fmt.Errorf("%w: public key must be lowercase hex", ErrInvalidAddress)
+	}
+
+	publicKey, err := hex.DecodeString(hexPart)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAddress, err)
+	}
+
+	return publicKey, nil
+}
+
+// DecodeAddress decodes a Kadena k: account
+func (k *KadenaAddress) DecodeAddress(address string) (*AddressInfo, error) {
+	publicKey, err := k.ParseAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AddressInfo{
+		Address:   address,
+		PublicKey: publicKey,
+		ChainID:   ChainKadena,
+		Type:      AddressTypeHex,
+	}, nil
+}
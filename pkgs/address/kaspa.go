@@ -2,14 +2,13 @@ package address
 
 import (
 	"fmt"
-	"strings"
 )
 
-// Kaspa address types
+// Kaspa address types (version byte)
 const (
-	KaspaAddressTypeP2PK  = 0x00 // Pay to Public Key (ECDSA)
-	KaspaAddressTypeP2PKE = 0x01 // Pay to Public Key (ECDSA) - alternative
-	KaspaAddressTypeP2SH  = 0x08 // Pay to Script Hash
+	KaspaAddressTypeP2PK  = 0x00 // Pay to Public Key (Schnorr, 32-byte x-only key)
+	KaspaAddressTypeP2PKE = 0x01 // Pay to Public Key (ECDSA, 33-byte compressed key)
+	KaspaAddressTypeP2SH  = 0x08 // Pay to Script Hash (32-byte BLAKE2b-256 script hash)
 )
 
 // KaspaAddress generates Kaspa (KAS) addresses
@@ -27,11 +26,22 @@ func NewKaspaTestnetAddress() *KaspaAddress {
 	return &KaspaAddress{testnet: true}
 }
 
+func init() {
+	registerChain(ChainKaspa,
+		func() AddressGenerator { return NewKaspaAddress() },
+		func() AddressGenerator { return NewKaspaTestnetAddress() })
+}
+
 // ChainID returns the chain identifier
 func (k *KaspaAddress) ChainID() ChainID {
 	return ChainKaspa
 }
 
+// Info describes the chain
+func (k *KaspaAddress) Info() ChainInfo {
+	return ChainInfo{ChainKaspa, "Kaspa", "KAS", "CashAddr", "Starts with 'kaspa:'"}
+}
+
 // Generate creates a Kaspa address from a public key
 // Public key should be 33 bytes (compressed secp256k1)
 func (k *KaspaAddress) Generate(publicKey []byte) (string, error) {
@@ -42,39 +52,61 @@ func (k *KaspaAddress) Generate(publicKey []byte) (string, error) {
 	return k.P2PK(publicKey)
 }
 
-// P2PK creates a Pay-to-Public-Key address
+// P2PK creates a Pay-to-Public-Key (Schnorr) address from a compressed public key
 func (k *KaspaAddress) P2PK(publicKey []byte) (string, error) {
 	if len(publicKey) != 33 {
 		return "", fmt.Errorf("invalid public key length for P2PK")
 	}
 
 	// Kaspa uses the public key directly (not hashed) for P2PK addresses
-	// Format: version byte (0x00) + 32-byte x-coordinate
-	// We extract x-coordinate from compressed public key (skip first byte)
-	xCoord := publicKey[1:33]
+	// Schnorr keys are x-only, so drop the parity byte of the compressed key
+	return k.GenerateSchnorr(publicKey[1:33])
+}
 
-	// Version byte for P2PK
-	data := make([]byte, 1+32)
-	data[0] = KaspaAddressTypeP2PK
-	copy(data[1:], xCoord)
+// GenerateSchnorr creates a Schnorr P2PK address (version 0) from a 32-byte x-only public key
+func (k *KaspaAddress) GenerateSchnorr(xOnlyPubKey []byte) (string, error) {
+	if len(xOnlyPubKey) != 32 {
+		return "", fmt.Errorf("invalid x-only public key length: expected 32, got %d", len(xOnlyPubKey))
+	}
+	return k.encode(KaspaAddressTypeP2PK, xOnlyPubKey)
+}
 
-	prefix := k.getPrefix()
-	return Bech32Encode(prefix, data, Bech32Standard)
+// GenerateECDSA creates an ECDSA P2PK address (version 1) from a 33-byte compressed public key
+func (k *KaspaAddress) GenerateECDSA(publicKey []byte) (string, error) {
+	if len(publicKey) != 33 {
+		return "", fmt.Errorf("invalid public key length: expected 33, got %d", len(publicKey))
+	}
+	return k.encode(KaspaAddressTypeP2PKE, publicKey)
 }
 
-// P2SH creates a Pay-to-Script-Hash address
-func (k *KaspaAddress) P2SH(scriptHash []byte) (string, error) {
+// GenerateP2SH creates a Pay-to-Script-Hash address (version 8) from a 32-byte script hash
+func (k *KaspaAddress) GenerateP2SH(scriptHash []byte) (string, error) {
 	if len(scriptHash) != 32 {
 		return "", fmt.Errorf("invalid script hash length: expected 32, got %d", len(scriptHash))
 	}
+	return k.encode(KaspaAddressTypeP2SH, scriptHash)
+}
+
+// P2SH creates a Pay-to-Script-Hash address
+func (k *KaspaAddress) P2SH(scriptHash []byte) (string, error) {
+	return k.GenerateP2SH(scriptHash)
+}
 
-	// Version byte for P2SH
-	data := make([]byte, 1+32)
-	data[0] = KaspaAddressTypeP2SH
-	copy(data[1:], scriptHash)
+// encode encodes the version byte and payload in CashAddr format
+func (k *KaspaAddress) encode(version byte, payload []byte) (string, error) {
+	return CashAddrEncode(k.getPrefix(), version, payload), nil
+}
 
-	prefix := k.getPrefix()
-	return Bech32Encode(prefix, data, Bech32Standard)
+// kaspaPayloadLength returns the expected payload length for a version byte, or 0 if unknown
+func kaspaPayloadLength(version byte) int {
+	switch version {
+	case KaspaAddressTypeP2PK, KaspaAddressTypeP2SH:
+		return 32
+	case KaspaAddressTypeP2PKE:
+		return 33
+	default:
+		return 0
+	}
 }
 
 // getPrefix returns the HRP (Human-Readable Part) for addresses
@@ -87,35 +119,20 @@ func (k *KaspaAddress) getPrefix() string {
 
 // Validate checks if a Kaspa address is valid
 func (k *KaspaAddress) Validate(address string) bool {
-	// Check prefix (Bech32 uses "1" as separator)
-	prefix := k.getPrefix()
-	if !strings.HasPrefix(address, prefix+"1") {
-		return false
-	}
-
-	// Try to decode
-	hrp, data, _, err := Bech32Decode(address)
+	// Try to decode (CashAddr uses ":" as separator)
+	prefix, version, payload, err := CashAddrDecode(address)
 	if err != nil {
 		return false
 	}
 
-	// Verify HRP
-	if hrp != prefix {
+	// Verify prefix
+	if prefix != k.getPrefix() {
 		return false
 	}
 
-	// Data should be 33 bytes (1 version + 32 payload)
-	if len(data) != 33 {
-		return false
-	}
-
-	// Verify version byte
-	version := data[0]
-	if version != KaspaAddressTypeP2PK && version != KaspaAddressTypeP2PKE && version != KaspaAddressTypeP2SH {
-		return false
-	}
-
-	return true
+	// Payload length depends on the version byte
+	expectedLen := kaspaPayloadLength(version)
+	return expectedLen != 0 && len(payload) == expectedLen
 }
 
 // GetAddressType returns the type of Kaspa address
@@ -124,16 +141,16 @@ func (k *KaspaAddress) GetAddressType(address string) (string, error) {
 		return "", ErrInvalidAddress
 	}
 
-	_, data, _, err := Bech32Decode(address)
+	_, version, _, err := CashAddrDecode(address)
 	if err != nil {
 		return "", err
 	}
 
-	switch data[0] {
+	switch version {
 	case KaspaAddressTypeP2PK:
-		return "P2PK (ECDSA)", nil
+		return "P2PK (Schnorr)", nil
 	case KaspaAddressTypeP2PKE:
-		return "P2PK (ECDSA alternative)", nil
+		return "P2PK (ECDSA)", nil
 	case KaspaAddressTypeP2SH:
 		return "P2SH", nil
 	default:
@@ -147,16 +164,16 @@ func (k *KaspaAddress) DecodeAddress(address string) (*AddressInfo, error) {
 		return nil, ErrInvalidAddress
 	}
 
-	_, data, _, err := Bech32Decode(address)
+	_, version, payload, err := CashAddrDecode(address)
 	if err != nil {
 		return nil, err
 	}
 
 	return &AddressInfo{
 		Address:   address,
-		PublicKey: data[1:], // Skip version byte
+		PublicKey: payload,
 		ChainID:   ChainKaspa,
-		Type:      AddressTypeBech32,
-		Version:   data[0],
+		Type:      AddressTypeCashAddr,
+		Version:   version,
 	}, nil
 }
@@ -23,15 +23,26 @@ func NewLitecoinAddress(testnet bool) *LitecoinAddress {
 	return &LitecoinAddress{testnet: testnet}
 }
 
+func init() {
+	registerChain(ChainLitecoin,
+		func() AddressGenerator { return NewLitecoinAddress(false) },
+		func() AddressGenerator { return NewLitecoinAddress(true) })
+}
+
 // ChainID returns the chain identifier
 func (l *LitecoinAddress) ChainID() ChainID {
 	return ChainLitecoin
 }
 
+// Info describes the chain
+func (l *LitecoinAddress) Info() ChainInfo {
+	return ChainInfo{ChainLitecoin, "Litecoin", "LTC", "Base58Check/Bech32", "Similar to Bitcoin with different prefixes"}
+}
+
 // P2PKH generates a Pay-to-Public-Key-Hash address (starts with L on mainnet)
 func (l *LitecoinAddress) P2PKH(publicKey []byte) (string, error) {
 	if len(publicKey) != 33 && len(publicKey) != 65 {
-		return "", ErrInvalidPublicKey
+		return "", keyLengthError(l.ChainID(), len(publicKey), 33, 65)
 	}
 
 	pubKeyHash := Hash160(publicKey)
@@ -63,7 +74,7 @@ func (l *LitecoinAddress) P2SH(redeemScript []byte) (string, error) {
 // Bech32 generates a native SegWit address (starts with ltc1 on mainnet)
 func (l *LitecoinAddress) Bech32(publicKey []byte) (string, error) {
 	if len(publicKey) != 33 {
-		return "", ErrInvalidPublicKey
+		return "", keyLengthError(l.ChainID(), len(publicKey), 33)
 	}
 
 	pubKeyHash := Hash160(publicKey)
@@ -84,12 +95,16 @@ func (l *LitecoinAddress) Generate(publicKey []byte) (string, error) {
 // Validate checks if an address is valid
 func (l *LitecoinAddress) Validate(address string) bool {
 	// Check for Bech32 addresses
-	if len(address) > 4 {
-		prefix := address[:4]
-		if prefix == "ltc1" || prefix == "tltc" {
-			_, _, _, err := SegWitDecode(address)
-			return err == nil
+	if hrp, ok := HRPOf(address); ok && (hrp == LitecoinBech32HRP || hrp == LitecoinTestnetBech32HRP) {
+		if _, _, _, err := SegWitDecode(address); err != nil {
+			return false
+		}
+
+		expected := LitecoinBech32HRP
+		if l.testnet {
+			expected = LitecoinTestnetBech32HRP
 		}
+		return hrp == expected
 	}
 
 	// Check for Base58Check addresses
@@ -1,9 +1,13 @@
 package address
 
 import (
+	"crypto/subtle"
+	"encoding/binary"
 	"fmt"
 
 	"golang.org/x/crypto/sha3"
+
+	"github.com/study/crypto-accounts/pkgs/crypto/ed25519"
 )
 
 // Monero network bytes
@@ -35,16 +39,27 @@ func NewMoneroTestnetAddress() *MoneroAddress {
 	return &MoneroAddress{testnet: true}
 }
 
+func init() {
+	registerChain(ChainMonero,
+		func() AddressGenerator { return NewMoneroAddress() },
+		func() AddressGenerator { return NewMoneroTestnetAddress() })
+}
+
 // ChainID returns the chain identifier
 func (m *MoneroAddress) ChainID() ChainID {
 	return ChainMonero
 }
 
+// Info describes the chain
+func (m *MoneroAddress) Info() ChainInfo {
+	return ChainInfo{ChainMonero, "Monero", "XMR", "Base58", "95 characters, starts with '4'"}
+}
+
 // Generate creates a Monero address from spend and view public keys
 // publicKey should be 64 bytes: 32-byte spend key + 32-byte view key
 func (m *MoneroAddress) Generate(publicKey []byte) (string, error) {
 	if len(publicKey) != 64 {
-		return "", fmt.Errorf("invalid public key length: expected 64 (32+32), got %d", len(publicKey))
+		return "", keyLengthError(m.ChainID(), len(publicKey), 64)
 	}
 
 	spendKey := publicKey[:32]
@@ -55,8 +70,8 @@ func (m *MoneroAddress) Generate(publicKey []byte) (string, error) {
 
 // GenerateStandard creates a standard Monero address
 func (m *MoneroAddress) GenerateStandard(spendPubKey, viewPubKey []byte) (string, error) {
-	if len(spendPubKey) != 32 || len(viewPubKey) != 32 {
-		return "", fmt.Errorf("both keys must be 32 bytes")
+	if err := moneroKeyLengths(spendPubKey, viewPubKey); err != nil {
+		return "", err
 	}
 
 	// Get network byte
@@ -83,10 +98,20 @@ func (m *MoneroAddress) GenerateStandard(spendPubKey, viewPubKey []byte) (string
 	return moneroBase58Encode(full), nil
 }
 
+// moneroKeyLengths checks that a spend and view public key are 32 bytes each
+func moneroKeyLengths(spendPubKey, viewPubKey []byte) error {
+	for _, key := range [][]byte{spendPubKey, viewPubKey} {
+		if len(key) != 32 {
+			return keyLengthError(ChainMonero, len(key), 32)
+		}
+	}
+	return nil
+}
+
 // GenerateSubaddress creates a Monero subaddress
 func (m *MoneroAddress) GenerateSubaddress(spendPubKey, viewPubKey []byte) (string, error) {
-	if len(spendPubKey) != 32 || len(viewPubKey) != 32 {
-		return "", fmt.Errorf("both keys must be 32 bytes")
+	if err := moneroKeyLengths(spendPubKey, viewPubKey); err != nil {
+		return "", err
 	}
 
 	var netByte byte
@@ -107,6 +132,61 @@ func (m *MoneroAddress) GenerateSubaddress(spendPubKey, viewPubKey []byte) (stri
 	return moneroBase58Encode(full), nil
 }
 
+// moneroSubaddressPrefix is the domain separator of the subaddress hash, NUL included
+const moneroSubaddressPrefix = "SubAddr\x00"
+
+// DeriveSubaddress derives the subaddress at index (major, minor) of a wallet
+// from its public spend key and secret view key. The subaddress spend key is
+// D = B + Hs("SubAddr" || a || major || minor)*G and its view key is C = a*D,
+// where B is the spend key and a the view key. Index (0, 0) is the wallet's
+// primary address, which is returned as a standard address.
+func (m *MoneroAddress) DeriveSubaddress(spendPubKey, viewSecKey []byte, major, minor uint32) (string, error) {
+	if len(spendPubKey) != 32 {
+		return "", keyLengthError(m.ChainID(), len(spendPubKey), 32)
+	}
+	if len(viewSecKey) != 32 {
+		return "", fmt.Errorf("%w: view key must be 32 bytes, got %d", ErrInvalidPrivateKey, len(viewSecKey))
+	}
+
+	// The view key is secret, so only the constant-time scalar operations see it
+	viewPubKey, err := ed25519.ScalarBaseMultCT(viewSecKey)
+	if err != nil {
+		return "", fmt.Errorf("%w: view key is not a reduced scalar", ErrInvalidPrivateKey)
+	}
+	if _, err := ed25519.DecodePoint(spendPubKey); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidPublicKey, err)
+	}
+
+	if major == 0 && minor == 0 {
+		return m.GenerateStandard(spendPubKey, viewPubKey)
+	}
+
+	data := make([]byte, 0, len(moneroSubaddressPrefix)+32+8)
+	data = append(data, moneroSubaddressPrefix...)
+	data = append(data, viewSecKey...)
+	data = binary.LittleEndian.AppendUint32(data, major)
+	data = binary.LittleEndian.AppendUint32(data, minor)
+	tweak, err := ed25519.ReduceScalar(keccak256(data))
+	if err != nil {
+		return "", err
+	}
+
+	tweakPoint, err := ed25519.ScalarBaseMultCT(tweak)
+	if err != nil {
+		return "", err
+	}
+	subSpend, err := ed25519.AddEncodedPoints(spendPubKey, tweakPoint)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidPublicKey, err)
+	}
+	subView, err := ed25519.ScalarMultCT(viewSecKey, subSpend)
+	if err != nil {
+		return "", err
+	}
+
+	return m.GenerateSubaddress(subSpend, subView)
+}
+
 // Validate checks if a Monero address is valid
 func (m *MoneroAddress) Validate(address string) bool {
 	// Monero addresses are 95 characters (standard/subaddress) or 106 characters (integrated)
@@ -148,10 +228,8 @@ func (m *MoneroAddress) Validate(address string) bool {
 	checksum := decoded[payloadLen:]
 	expectedChecksum := keccak256(payload)[:4]
 
-	for i := 0; i < 4; i++ {
-		if checksum[i] != expectedChecksum[i] {
-			return false
-		}
+	if subtle.ConstantTimeCompare(checksum, expectedChecksum) != 1 {
+		return false
 	}
 
 	return true
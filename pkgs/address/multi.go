@@ -0,0 +1,145 @@
+package address
+
+import (
+	"sort"
+
+	"github.com/study/crypto-accounts/pkgs/crypto/ed25519"
+	"github.com/study/crypto-accounts/pkgs/crypto/secp256k1"
+)
+
+// Curve identifies the signature curve a chain's keys live on
+type Curve string
+
+const (
+	CurveSecp256k1 Curve = "secp256k1"
+	CurveEd25519   Curve = "ed25519"
+)
+
+// ChainCurves maps chains to the curve their default generator expects.
+// Chains needing other key material (Monero's key pair, Arweave's RSA) are absent.
+var ChainCurves = map[ChainID]Curve{
+	// secp256k1
+	ChainBitcoin:         CurveSecp256k1,
+	ChainLitecoin:        CurveSecp256k1,
+	ChainDogecoin:        CurveSecp256k1,
+	ChainBitcoinCash:     CurveSecp256k1,
+	ChainEthereum:        CurveSecp256k1,
+	ChainBSC:             CurveSecp256k1,
+	ChainPolygon:         CurveSecp256k1,
+	ChainFantom:          CurveSecp256k1,
+	ChainOptimism:        CurveSecp256k1,
+	ChainArbitrum:        CurveSecp256k1,
+	ChainBase:            CurveSecp256k1,
+	ChainZkSync:          CurveSecp256k1,
+	ChainLinea:           CurveSecp256k1,
+	ChainScroll:          CurveSecp256k1,
+	ChainVeChain:         CurveSecp256k1,
+	ChainTheta:           CurveSecp256k1,
+	ChainEthereumClassic: CurveSecp256k1,
+	ChainAvalanche:       CurveSecp256k1,
+	ChainCosmos:          CurveSecp256k1,
+	ChainBinanceBEP2:     CurveSecp256k1,
+	ChainSei:             CurveSecp256k1,
+	ChainTron:            CurveSecp256k1,
+	ChainRipple:          CurveSecp256k1,
+	ChainZcash:           CurveSecp256k1,
+	ChainKaspa:           CurveSecp256k1,
+	ChainStacks:          CurveSecp256k1,
+	ChainFilecoin:        CurveSecp256k1,
+	ChainEOS:             CurveSecp256k1,
+	ChainCKB:             CurveSecp256k1,
+	ChainQtum:            CurveSecp256k1,
+
+	// Ed25519
+	ChainSolana:   CurveEd25519,
+	ChainStellar:  CurveEd25519,
+	ChainAlgorand: CurveEd25519,
+	ChainNEAR:     CurveEd25519,
+	ChainCardano:  CurveEd25519,
+	ChainPolkadot: CurveEd25519,
+	ChainAptos:    CurveEd25519,
+	ChainSui:      CurveEd25519,
+	ChainTezos:    CurveEd25519,
+	ChainHedera:   CurveEd25519,
+	ChainICP:      CurveEd25519,
+	ChainIOTA:     CurveEd25519,
+	ChainShimmer:  CurveEd25519,
+	ChainWaves:    CurveEd25519,
+	ChainKadena:   CurveEd25519,
+}
+
+// uncompressedKey returns the 65-byte uncompressed form of a secp256k1 public
+// key. Generators of chains that hash the uncompressed key use it so callers
+// can pass the natural compressed key; uncompressed input is returned as is.
+func uncompressedKey(publicKey []byte) ([]byte, error) {
+	if len(publicKey) != secp256k1.CompressedPubKeyLen {
+		return publicKey, nil
+	}
+
+	point, err := secp256k1.DecompressPoint(publicKey)
+	if err != nil {
+		return nil, ErrInvalidPublicKey
+	}
+	return secp256k1.SerializeUncompressed(point), nil
+}
+
+// GenerateAll generates an address on every registered chain whose curve has a
+// key in publicKeyByCurve: a compressed secp256k1 key or a 32-byte Ed25519 key.
+// Chains that fail to generate are omitted.
+func (f *Factory) GenerateAll(publicKeyByCurve map[Curve][]byte) map[ChainID]string {
+	addresses := make(map[ChainID]string)
+	for chainID, gen := range f.snapshot() {
+		curve, ok := ChainCurves[chainID]
+		if !ok {
+			continue
+		}
+		key, ok := publicKeyByCurve[curve]
+		if !ok {
+			continue
+		}
+
+		if addr, err := gen.Generate(key); err == nil {
+			addresses[chainID] = addr
+		}
+	}
+
+	return addresses
+}
+
+// FromPrivateKey derives the secp256k1 and Ed25519 public keys of a 32-byte
+// private key and generates an address on every compatible chain.
+func (f *Factory) FromPrivateKey(privateKey []byte) (map[ChainID]string, error) {
+	if !secp256k1.IsValidPrivateKey(privateKey) || len(privateKey) != 32 {
+		return nil, ErrInvalidPrivateKey
+	}
+
+	edPub, err := ed25519.PrivateKeyToPublicKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.GenerateAll(map[Curve][]byte{
+		CurveSecp256k1: secp256k1.PrivateKeyToCompressedPublicKey(privateKey),
+		CurveEd25519:   edPub,
+	}), nil
+}
+
+// GenerateAll generates addresses on every compatible chain using the default factory
+func GenerateAll(publicKeyByCurve map[Curve][]byte) map[ChainID]string {
+	return DefaultFactory.GenerateAll(publicKeyByCurve)
+}
+
+// FromPrivateKey generates addresses on every compatible chain using the default factory
+func FromPrivateKey(privateKey []byte) (map[ChainID]string, error) {
+	return DefaultFactory.FromPrivateKey(privateKey)
+}
+
+// SortedChains returns the chain IDs of an address map in sorted order
+func SortedChains(addresses map[ChainID]string) []ChainID {
+	chains := make([]ChainID, 0, len(addresses))
+	for chainID := range addresses {
+		chains = append(chains, chainID)
+	}
+	sort.Slice(chains, func(i, j int) bool { return chains[i] < chains[j] })
+	return chains
+}
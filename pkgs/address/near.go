@@ -2,11 +2,14 @@ package address
 
 import (
 	"encoding/hex"
-	"fmt"
 	"regexp"
 	"strings"
 )
 
+// nearAccountPattern is the NEAR account ID rule: dot-separated parts of
+// lowercase alphanumeric runs joined by single '-' or '_' separators
+var nearAccountPattern = regexp.MustCompile(`^(([a-z0-9]+[-_])*[a-z0-9]+\.)*([a-z0-9]+[-_])*[a-z0-9]+$`)
+
 // NEARAddress generates NEAR Protocol addresses
 type NEARAddress struct{}
 
@@ -15,17 +18,26 @@ func NewNEARAddress() *NEARAddress {
 	return &NEARAddress{}
 }
 
+func init() {
+	registerChain(ChainNEAR, func() AddressGenerator { return NewNEARAddress() }, nil)
+}
+
 // ChainID returns the chain identifier
 func (n *NEARAddress) ChainID() ChainID {
 	return ChainNEAR
 }
 
+// Info describes the chain
+func (n *NEARAddress) Info() ChainInfo {
+	return ChainInfo{ChainNEAR, "NEAR Protocol", "NEAR", "Hex/Named", "64 hex chars or named accounts"}
+}
+
 // Generate creates a NEAR implicit address from an Ed25519 public key
 // Public key should be 32 bytes
 // Implicit addresses are 64 hex characters (the public key itself)
 func (n *NEARAddress) Generate(publicKey []byte) (string, error) {
 	if len(publicKey) != 32 {
-		return "", fmt.Errorf("NEAR requires 32-byte Ed25519 public key, got %d bytes", len(publicKey))
+		return "", keyLengthError(n.ChainID(), len(publicKey), 32)
 	}
 
 	// NEAR implicit addresses are hex-encoded public keys
@@ -34,8 +46,8 @@ func (n *NEARAddress) Generate(publicKey []byte) (string, error) {
 
 // ValidateImplicit checks if an implicit address is valid
 func (n *NEARAddress) ValidateImplicit(address string) bool {
-	// Implicit addresses are 64 hex characters
-	if len(address) != 64 {
+	// Implicit addresses are 64 lowercase hex characters
+	if len(address) != 64 || strings.ToLower(address) != address {
 		return false
 	}
 
@@ -49,16 +61,19 @@ func (n *NEARAddress) ValidateNamed(address string) bool {
 	// Named accounts:
 	// - 2-64 characters
 	// - Lowercase letters, digits, underscores, hyphens
-	// - Must not start with hyphen or underscore
+	// - Separators ('-', '_', '.') never lead, trail or follow each other
 	// - Can contain periods for sub-accounts (alice.near)
 
 	if len(address) < 2 || len(address) > 64 {
 		return false
 	}
 
-	// Check for valid characters
-	validPattern := regexp.MustCompile(`^[a-z0-9]([a-z0-9_-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9_-]*[a-z0-9])?)*$`)
-	return validPattern.MatchString(address)
+	// 64 hex characters are an implicit account, which cannot be registered by name
+	if n.ValidateImplicit(address) {
+		return false
+	}
+
+	return nearAccountPattern.MatchString(address)
 }
 
 // Validate checks if a NEAR address is valid (either implicit or named)
@@ -96,7 +111,7 @@ func (n *NEARAddress) DecodeAddress(address string) (*AddressInfo, error) {
 	if n.IsImplicit(address) {
 		decoded, _ := hex.DecodeString(address)
 		info.PublicKey = decoded
-		info.Type = AddressTypeBase58 // Actually hex
+		info.Type = AddressTypeHex
 	} else {
 		info.PublicKey = []byte(address) // Named address
 		info.Type = AddressTypeBase58
@@ -0,0 +1,92 @@
+package address
+
+// NEO legacy (N2) address constants. Ontology uses the same version byte and
+// single-signature verification script, so its addresses match NEO's.
+const (
+	NeoAddressVersion byte = 0x17 // Prefix: A
+
+	// Verification script opcodes: PUSHBYTES33 <pubkey> CHECKSIG
+	NeoOpPushBytes33 byte = 0x21
+	NeoOpCheckSig    byte = 0xAC
+)
+
+// NeoAddress generates NEO legacy and Ontology addresses
+type NeoAddress struct {
+	version byte
+}
+
+// NewNeoAddress creates a new NEO legacy address generator
+func NewNeoAddress() *NeoAddress {
+	return &NeoAddress{version: NeoAddressVersion}
+}
+
+// NewNeoAddressWithVersion creates a NEO-style generator for a chain with another version byte
+func NewNeoAddressWithVersion(version byte) *NeoAddress {
+	return &NeoAddress{version: version}
+}
+
+func init() {
+	registerChain(ChainNeo, func() AddressGenerator { return NewNeoAddress() }, nil)
+}
+
+// ChainID returns the chain identifier
+func (n *NeoAddress) ChainID() ChainID {
+	return ChainNeo
+}
+
+// Info describes the chain
+func (n *NeoAddress) Info() ChainInfo {
+	return ChainInfo{ChainNeo, "NEO", "NEO", "Base58Check", "Legacy (N2) addresses, starts with 'A'"}
+}
+
+// VerificationScript returns the single-signature script for a compressed public key
+func (n *NeoAddress) VerificationScript(publicKey []byte) ([]byte, error) {
+	if len(publicKey) != 33 {
+		return nil, keyLengthError(n.ChainID(), len(publicKey), 33)
+	}
+
+	script := make([]byte, 0, 35)
+	script = append(script, NeoOpPushBytes33)
+	script = append(script, publicKey...)
+	script = append(script, NeoOpCheckSig)
+	return script, nil
+}
+
+// Generate creates a NEO address: Base58Check(version || Hash160(verification script))
+// Public key should be 33 bytes (compressed secp256r1 on NEO, or secp256k1)
+func (n *NeoAddress) Generate(publicKey []byte) (string, error) {
+	script, err := n.VerificationScript(publicKey)
+	if err != nil {
+		return "", err
+	}
+
+	return Base58CheckEncode(n.version, Hash160(script)), nil
+}
+
+// Validate checks if a NEO address is valid
+func (n *NeoAddress) Validate(address string) bool {
+	version, payload, err := Base58CheckDecode(address)
+	if err != nil {
+		return false
+	}
+
+	return version == n.version && len(payload) == 20
+}
+
+// DecodeAddress decodes a NEO address
+// PublicKey holds the script hash.
+func (n *NeoAddress) DecodeAddress(address string) (*AddressInfo, error) {
+	if !n.Validate(address) {
+		return nil, ErrInvalidAddress
+	}
+
+	version, payload, _ := Base58CheckDecode(address)
+
+	return &AddressInfo{
+		Address:   address,
+		PublicKey: payload,
+		ChainID:   ChainNeo,
+		Type:      AddressTypeBase58Check,
+		Version:   version,
+	}, nil
+}
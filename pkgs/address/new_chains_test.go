@@ -1,9 +1,15 @@
 package address
 
 import (
+	"bytes"
 	"encoding/hex"
+	"errors"
+	"math/big"
+	"slices"
 	"strings"
 	"testing"
+
+	"github.com/study/crypto-accounts/pkgs/crypto/ed25519"
 )
 
 // TestTezosAddress tests Tezos (XTZ) address generation
@@ -73,6 +79,126 @@ func TestTezosAddressSecp256k1(t *testing.T) {
 	}
 }
 
+func TestTezosAddressBLS(t *testing.T) {
+	tezos := NewTezosAddressWithKeyType(TezosKeyBLS)
+
+	// Compressed BLS12-381 G1 generator (48 bytes)
+	pubKey, _ := hex.DecodeString("97f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb")
+
+	addr, err := tezos.GenerateTz4(pubKey)
+	if err != nil {
+		t.Fatalf("GenerateTz4() error = %v", err)
+	}
+	if !strings.HasPrefix(addr, "tz4") || len(addr) != 36 {
+		t.Errorf("GenerateTz4() = %s, want 36-character tz4 address", addr)
+	}
+	if generated, _ := tezos.Generate(pubKey); generated != addr {
+		t.Errorf("Generate() = %s, want %s", generated, addr)
+	}
+
+	if !tezos.Validate(addr) {
+		t.Error("Address validation failed")
+	}
+	if addrType, err := tezos.GetAddressType(addr); err != nil || addrType != "BLS" {
+		t.Errorf("GetAddressType(%s) = %s, %v, want BLS", addr, addrType, err)
+	}
+	info, err := tezos.DecodeAddress(addr)
+	if err != nil {
+		t.Fatalf("DecodeAddress() error = %v", err)
+	}
+	if hex.EncodeToString(info.PublicKey) != hex.EncodeToString(blake2b160(pubKey)) {
+		t.Errorf("DecodeAddress() hash = %x, want %x", info.PublicKey, blake2b160(pubKey))
+	}
+
+	blpk, err := tezos.EncodePublicKey(pubKey)
+	if err != nil {
+		t.Fatalf("EncodePublicKey() error = %v", err)
+	}
+	if !strings.HasPrefix(blpk, "BLpk") || len(blpk) != 76 {
+		t.Errorf("EncodePublicKey() = %s, want 76-character BLpk key", blpk)
+	}
+	if keyType, decoded, err := DecodeTezosPublicKey(blpk); err != nil || keyType != TezosKeyBLS || hex.EncodeToString(decoded) != hex.EncodeToString(pubKey) {
+		t.Errorf("DecodeTezosPublicKey(%s) = %d, %x, %v", blpk, keyType, decoded, err)
+	}
+
+	if _, err := tezos.GenerateTz4(pubKey[:33]); err == nil {
+		t.Error("GenerateTz4() should reject a 33-byte key")
+	}
+
+	// A published BLpk key and its tz4 address
+	const publishedBLpk = "BLpk1nRV5SBB2QCxsiem5Neoywcizr3mkdp167HL1iKFgFvzPhKo4RSy7J8JBh2BgGgVYjNsRGwU"
+	keyType, published, err := DecodeTezosPublicKey(publishedBLpk)
+	if err != nil || keyType != TezosKeyBLS {
+		t.Fatalf("DecodeTezosPublicKey(%s) = %d, %v, want BLS", publishedBLpk, keyType, err)
+	}
+	if addr, _ := tezos.GenerateTz4(published); addr != "tz4XXtsYav3fZz2FSDa7hcx4F8sh8SaDWNME" {
+		t.Errorf("GenerateTz4(%s) = %s, want tz4XXtsYav3fZz2FSDa7hcx4F8sh8SaDWNME", publishedBLpk, addr)
+	}
+}
+
+func TestTezosEncodePublicKey(t *testing.T) {
+	edKey, _ := hex.DecodeString("d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a")
+
+	edpk, err := NewTezosAddress().EncodePublicKey(edKey)
+	if err != nil {
+		t.Fatalf("EncodePublicKey() error = %v", err)
+	}
+	if !strings.HasPrefix(edpk, "edpk") || len(edpk) != 54 {
+		t.Errorf("EncodePublicKey() = %s, want 54-character edpk key", edpk)
+	}
+
+	keyType, decoded, err := DecodeTezosPublicKey(edpk)
+	if err != nil {
+		t.Fatalf("DecodeTezosPublicKey() error = %v", err)
+	}
+	if keyType != TezosKeyEd25519 || hex.EncodeToString(decoded) != hex.EncodeToString(edKey) {
+		t.Errorf("DecodeTezosPublicKey() = %d, %x, want %d, %x", keyType, decoded, TezosKeyEd25519, edKey)
+	}
+
+	secpKey, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	sppk, err := NewTezosAddressWithKeyType(TezosKeySecp256k1).EncodePublicKey(secpKey)
+	if err != nil {
+		t.Fatalf("EncodePublicKey() error = %v", err)
+	}
+	if !strings.HasPrefix(sppk, "sppk") {
+		t.Errorf("EncodePublicKey() = %s, want sppk prefix", sppk)
+	}
+	if keyType, decoded, err := DecodeTezosPublicKey(sppk); err != nil || keyType != TezosKeySecp256k1 || hex.EncodeToString(decoded) != hex.EncodeToString(secpKey) {
+		t.Errorf("DecodeTezosPublicKey(%s) = %d, %x, %v", sppk, keyType, decoded, err)
+	}
+
+	if _, err := NewTezosAddress().EncodePublicKey(secpKey); err == nil {
+		t.Error("EncodePublicKey() should reject a 33-byte key for Ed25519")
+	}
+
+	// An address is not a public key
+	tz1, _ := NewTezosAddress().Generate(edKey)
+	if _, _, err := DecodeTezosPublicKey(tz1); err == nil {
+		t.Error("DecodeTezosPublicKey() should reject an address")
+	}
+
+	// Octez sandbox bootstrap accounts
+	bootstrap := []struct {
+		edpk string
+		tz1  string
+	}{
+		{"edpkuBknW28nW72KG6RoHtYW7p12T6GKc7nAbwYX5m8Wd9sDVC9yav", "tz1KqTpEZ7Yob7QbPE4Hy4Wo8fHG8LhKxZSx"},
+		{"edpkuTXkJDGcFd5nh6VvMz8phXxU3Bi7h6hqgywNFi1vZTfQNnS1RV", "tz1faswCTDciRzE4oJ9jn2Vm2dvjeyA9fUzU"},
+	}
+	for _, tt := range bootstrap {
+		keyType, pubKey, err := DecodeTezosPublicKey(tt.edpk)
+		if err != nil || keyType != TezosKeyEd25519 {
+			t.Fatalf("DecodeTezosPublicKey(%s) = %d, %v, want Ed25519", tt.edpk, keyType, err)
+		}
+		if encoded, _ := NewTezosAddress().EncodePublicKey(pubKey); encoded != tt.edpk {
+			t.Errorf("EncodePublicKey(%x) = %s, want %s", pubKey, encoded, tt.edpk)
+		}
+		if addr, _ := NewTezosAddress().Generate(pubKey); addr != tt.tz1 {
+			t.Errorf("Generate(%s) = %s, want %s", tt.edpk, addr, tt.tz1)
+		}
+	}
+}
+
 // TestZcashAddress tests Zcash (ZEC) transparent address generation
 func TestZcashAddress(t *testing.T) {
 	zcash := NewZcashAddress()
@@ -126,9 +252,9 @@ func TestKaspaAddress(t *testing.T) {
 		t.Fatalf("Generate() error = %v", err)
 	}
 
-	// Kaspa addresses start with "kaspa1" (Bech32 format)
-	if !strings.HasPrefix(addr, "kaspa1") {
-		t.Errorf("Address should start with kaspa1, got %s", addr)
+	// Kaspa addresses start with "kaspa:" (CashAddr format)
+	if !strings.HasPrefix(addr, "kaspa:") {
+		t.Errorf("Address should start with kaspa:, got %s", addr)
 	}
 
 	// Validate
@@ -151,6 +277,66 @@ func TestKaspaAddress(t *testing.T) {
 	}
 }
 
+// TestKaspaAddressVariants tests Kaspa Schnorr, ECDSA and P2SH address versions
+func TestKaspaAddressVariants(t *testing.T) {
+	kaspa := NewKaspaAddress()
+
+	pubKey, _ := hex.DecodeString("0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798")
+	xOnly := pubKey[1:]
+	scriptHash := Blake2b256([]byte("script"))
+
+	schnorrAddr, err := kaspa.GenerateSchnorr(xOnly)
+	if err != nil {
+		t.Fatalf("GenerateSchnorr() error = %v", err)
+	}
+	ecdsaAddr, err := kaspa.GenerateECDSA(pubKey)
+	if err != nil {
+		t.Fatalf("GenerateECDSA() error = %v", err)
+	}
+	p2shAddr, err := kaspa.GenerateP2SH(scriptHash)
+	if err != nil {
+		t.Fatalf("GenerateP2SH() error = %v", err)
+	}
+
+	// Generate with a compressed key produces the Schnorr form of its x-coordinate
+	defaultAddr, _ := kaspa.Generate(pubKey)
+	if defaultAddr != schnorrAddr {
+		t.Errorf("Generate() = %s, want Schnorr address %s", defaultAddr, schnorrAddr)
+	}
+
+	tests := []struct {
+		addr     string
+		wantType string
+		version  byte
+		keyLen   int
+	}{
+		{schnorrAddr, "P2PK (Schnorr)", KaspaAddressTypeP2PK, 32},
+		{ecdsaAddr, "P2PK (ECDSA)", KaspaAddressTypeP2PKE, 33},
+		{p2shAddr, "P2SH", KaspaAddressTypeP2SH, 32},
+	}
+	for _, tt := range tests {
+		if !kaspa.Validate(tt.addr) {
+			t.Errorf("Validate(%s) = false, want true", tt.addr)
+			continue
+		}
+		addrType, _ := kaspa.GetAddressType(tt.addr)
+		if addrType != tt.wantType {
+			t.Errorf("GetAddressType(%s) = %s, want %s", tt.addr, addrType, tt.wantType)
+		}
+		info, _ := kaspa.DecodeAddress(tt.addr)
+		if info.Version != tt.version || len(info.PublicKey) != tt.keyLen {
+			t.Errorf("DecodeAddress(%s) = version %d, %d-byte payload", tt.addr, info.Version, len(info.PublicKey))
+		}
+	}
+
+	if _, err := kaspa.GenerateSchnorr(pubKey); err == nil {
+		t.Error("GenerateSchnorr() should reject a 33-byte key")
+	}
+	if _, err := kaspa.GenerateECDSA(xOnly); err == nil {
+		t.Error("GenerateECDSA() should reject a 32-byte key")
+	}
+}
+
 // TestStacksAddress tests Stacks (STX) address generation
 func TestStacksAddress(t *testing.T) {
 	stacks := NewStacksAddress()
@@ -190,6 +376,46 @@ func TestStacksAddress(t *testing.T) {
 	}
 }
 
+// TestStacksC32Check tests c32check against the reference implementation's
+// vectors and payloads with leading zero bytes
+func TestStacksC32Check(t *testing.T) {
+	tests := []struct {
+		version byte
+		hash    string
+		want    string
+	}{
+		{StacksMainnetSingleSig, "a46ff88886c2ef9762d970b4d2c63678835bd39d", "SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7"},
+		{0, "a46ff88886c2ef9762d970b4d2c63678835bd39d", "S02J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKPVKG2CE"},
+		{StacksMainnetSingleSig, "0000000000000000000000000000000000000000", "SP000000000000000000002Q6VF78"},
+	}
+
+	for _, tt := range tests {
+		hash, _ := hex.DecodeString(tt.hash)
+		got, err := c32CheckEncode(tt.version, hash)
+		if err != nil {
+			t.Fatalf("c32CheckEncode() error = %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("c32CheckEncode(%s) = %s, want %s", tt.hash, got, tt.want)
+		}
+	}
+
+	for _, hashHex := range []string{"00a46ff88886c2ef9762d970b4d2c63678835bd3", "0000f88886c2ef9762d970b4d2c63678835bd39d"} {
+		hash, _ := hex.DecodeString(hashHex)
+		addr, err := c32CheckEncode(StacksTestnetSingleSig, hash)
+		if err != nil {
+			t.Fatalf("c32CheckEncode() error = %v", err)
+		}
+		version, decoded, err := c32CheckDecode(addr)
+		if err != nil {
+			t.Fatalf("c32CheckDecode(%s) error = %v", addr, err)
+		}
+		if version != StacksTestnetSingleSig || hex.EncodeToString(decoded) != hashHex {
+			t.Errorf("c32CheckDecode(%s) = %d, %x, want %d, %s", addr, version, decoded, StacksTestnetSingleSig, hashHex)
+		}
+	}
+}
+
 // TestFilecoinAddress tests Filecoin (FIL) address generation
 func TestFilecoinAddress(t *testing.T) {
 	filecoin := NewFilecoinAddress()
@@ -231,6 +457,48 @@ func TestFilecoinAddress(t *testing.T) {
 	}
 }
 
+// TestFilecoinIDAddress tests Filecoin f0 (actor ID) addresses
+func TestFilecoinIDAddress(t *testing.T) {
+	filecoin := NewFilecoinAddress()
+
+	tests := []struct {
+		id    uint64
+		addr  string
+		bytes string
+	}{
+		{0, "f00", "0000"},
+		{127, "f0127", "007f"},
+		{128, "f0128", "008001"},
+		{1234, "f01234", "00d209"},
+	}
+
+	for _, tt := range tests {
+		if got := filecoin.IDAddress(tt.id); got != tt.addr {
+			t.Errorf("IDAddress(%d) = %s, want %s", tt.id, got, tt.addr)
+		}
+		if !filecoin.Validate(tt.addr) {
+			t.Errorf("Validate(%s) = false, want true", tt.addr)
+		}
+		if id, err := filecoin.ParseIDAddress(tt.addr); err != nil || id != tt.id {
+			t.Errorf("ParseIDAddress(%s) = %d, %v, want %d", tt.addr, id, err, tt.id)
+		}
+
+		raw := IDAddressBytes(tt.id)
+		if hex.EncodeToString(raw) != tt.bytes {
+			t.Errorf("IDAddressBytes(%d) = %x, want %s", tt.id, raw, tt.bytes)
+		}
+		if id, err := IDFromAddressBytes(raw); err != nil || id != tt.id {
+			t.Errorf("IDFromAddressBytes(%x) = %d, %v, want %d", raw, id, err, tt.id)
+		}
+	}
+
+	for _, addr := range []string{"f0", "f01a", "f001", "t01234", "f018446744073709551616"} {
+		if filecoin.Validate(addr) {
+			t.Errorf("Validate(%s) = true, want false", addr)
+		}
+	}
+}
+
 // TestHederaAddress tests Hedera (HBAR) address generation
 func TestHederaAddress(t *testing.T) {
 	hedera := NewHederaAddress()
@@ -281,6 +549,53 @@ func TestHederaAddress(t *testing.T) {
 	}
 }
 
+// TestHederaKeyTypes tests Ed25519 key aliases, ECDSA EVM address aliases and DER input
+func TestHederaKeyTypes(t *testing.T) {
+	hedera := NewHederaAddress()
+
+	edKey, _ := hex.DecodeString("a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed")
+	// Public key of private key 1
+	ecdsaKey, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+
+	tests := []struct {
+		name     string
+		key      []byte
+		want     string
+		wantType string
+	}{
+		{"ed25519", edKey, "0.0.a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed", "Ed25519 Alias"},
+		{"ecdsa", ecdsaKey, "0.0.7e5f4552091a69125d5dfcb7b8c2659029395bdf", "EVM Address Alias"},
+		{"ed25519 DER", append(append([]byte{}, HederaEd25519DERPrefix...), edKey...), "0.0.a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed", "Ed25519 Alias"},
+		{"ecdsa DER", append(append([]byte{}, HederaECDSADERPrefix...), ecdsaKey...), "0.0.7e5f4552091a69125d5dfcb7b8c2659029395bdf", "EVM Address Alias"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, err := hedera.Generate(tt.key)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+			if addr != tt.want {
+				t.Errorf("Generate() = %s, want %s", addr, tt.want)
+			}
+			if !hedera.Validate(addr) {
+				t.Errorf("Validate(%s) = false, want true", addr)
+			}
+			addrType, err := hedera.GetAddressType(addr)
+			if err != nil {
+				t.Fatalf("GetAddressType() error = %v", err)
+			}
+			if addrType != tt.wantType {
+				t.Errorf("GetAddressType() = %s, want %s", addrType, tt.wantType)
+			}
+		})
+	}
+
+	if _, err := hedera.GenerateFromECDSA(edKey); err == nil {
+		t.Error("GenerateFromECDSA(32-byte key) error = nil, want error")
+	}
+}
+
 // TestICPAddress tests Internet Computer (ICP) Principal ID generation
 func TestICPAddress(t *testing.T) {
 	icp := NewICPAddress()
@@ -320,6 +635,57 @@ func TestICPAddress(t *testing.T) {
 	}
 }
 
+// TestICPWellKnownPrincipals checks principals whose text form is fixed by the ICP spec
+func TestICPWellKnownPrincipals(t *testing.T) {
+	icp := NewICPAddress()
+
+	// The anonymous principal is the single byte 0x04
+	if !icp.Validate("2vxsx-fae") {
+		t.Fatal("Validate(2vxsx-fae) = false, want true")
+	}
+	addrType, err := icp.GetAddressType("2vxsx-fae")
+	if err != nil {
+		t.Fatalf("GetAddressType() error = %v", err)
+	}
+	if addrType != "Anonymous Principal" {
+		t.Errorf("GetAddressType() = %s, want Anonymous Principal", addrType)
+	}
+
+	// Wrong checksum
+	if icp.Validate("2vxsx-fai") {
+		t.Error("Validate(2vxsx-fai) = true, want false")
+	}
+
+	// NNS canister ids are opaque principals: a big-endian index followed by 0x01 0x01
+	canisters := []struct {
+		name      string
+		principal string
+		index     byte
+	}{
+		{"registry", "rwlgt-iiaaa-aaaaa-aaaaa-cai", 0},
+		{"governance", "rrkah-fqaaa-aaaaa-aaaaq-cai", 1},
+		{"ledger", "ryjl3-tyaaa-aaaaa-aaaba-cai", 2},
+	}
+	for _, tt := range canisters {
+		t.Run(tt.name, func(t *testing.T) {
+			data := []byte{0, 0, 0, 0, 0, 0, 0, tt.index, 0x01, 0x01}
+			if got := icp.encodePrincipal(data); got != tt.principal {
+				t.Errorf("encodePrincipal() = %s, want %s", got, tt.principal)
+			}
+			if !icp.Validate(tt.principal) {
+				t.Errorf("Validate(%s) = false, want true", tt.principal)
+			}
+			addrType, err := icp.GetAddressType(tt.principal)
+			if err != nil {
+				t.Fatalf("GetAddressType() error = %v", err)
+			}
+			if addrType != "Opaque Principal" {
+				t.Errorf("GetAddressType() = %s, want Opaque Principal", addrType)
+			}
+		})
+	}
+}
+
 // TestEOSAddress tests EOS address/public key generation
 func TestEOSAddress(t *testing.T) {
 	eos := NewEOSAddress()
@@ -542,6 +908,98 @@ func TestMoneroAddress(t *testing.T) {
 	}
 }
 
+// TestMoneroDeriveSubaddress tests subaddress derivation with the Monero
+// general fund's published secret view key
+func TestMoneroDeriveSubaddress(t *testing.T) {
+	const primary = "44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3A"
+	viewSecKey, _ := hex.DecodeString("f359631075708155cc3d92a32b75a7d02a5dcf27756707b47a2b31b21c389501")
+
+	monero := NewMoneroAddress()
+	info, err := monero.DecodeAddress(primary)
+	if err != nil {
+		t.Fatalf("DecodeAddress() error = %v", err)
+	}
+	spendPubKey := info.PublicKey[:32]
+
+	// Index (0, 0) is the primary address: its view key is a*G
+	addr, err := monero.DeriveSubaddress(spendPubKey, viewSecKey, 0, 0)
+	if err != nil {
+		t.Fatalf("DeriveSubaddress(0, 0) error = %v", err)
+	}
+	if addr != primary {
+		t.Errorf("DeriveSubaddress(0, 0) = %s, want %s", addr, primary)
+	}
+
+	// Other indices are subaddresses whose view key is a*D, checked here
+	// against the big.Int point arithmetic
+	le := slices.Clone(viewSecKey)
+	slices.Reverse(le)
+	a := new(big.Int).SetBytes(le)
+	seen := map[string]bool{}
+	for _, idx := range [][2]uint32{{0, 1}, {0, 2}, {1, 0}} {
+		sub, err := monero.DeriveSubaddress(spendPubKey, viewSecKey, idx[0], idx[1])
+		if err != nil {
+			t.Fatalf("DeriveSubaddress(%d, %d) error = %v", idx[0], idx[1], err)
+		}
+		if sub[0] != '8' || seen[sub] {
+			t.Errorf("DeriveSubaddress(%d, %d) = %s, want a new subaddress", idx[0], idx[1], sub)
+		}
+		seen[sub] = true
+
+		subInfo, err := monero.DecodeAddress(sub)
+		if err != nil {
+			t.Fatalf("DecodeAddress(%s) error = %v", sub, err)
+		}
+		subSpend, err := ed25519.DecodePoint(subInfo.PublicKey[:32])
+		if err != nil {
+			t.Fatalf("DecodePoint() error = %v", err)
+		}
+		if got := ed25519.ScalarMult(subSpend, a).Bytes(); hex.EncodeToString(got) != hex.EncodeToString(subInfo.PublicKey[32:]) {
+			t.Errorf("DeriveSubaddress(%d, %d) view key = %x, want a*D = %x", idx[0], idx[1], subInfo.PublicKey[32:], got)
+		}
+	}
+
+	// The general fund's donation subaddress published on getmonero.org
+	const donation = "888tNkZrPN6JsEgekjMnABU4TBzc2Dt29EPAvkRxbANsAnjyPbb3iQ1YBRk1UXcdRsiKc9dhwMVgN5S9cQUiyoogDavup3H"
+	if sub, err := monero.DeriveSubaddress(spendPubKey, viewSecKey, 0, 70); err != nil || sub != donation {
+		t.Errorf("DeriveSubaddress(0, 70) = %s, %v, want %s", sub, err, donation)
+	}
+
+	if _, err := monero.DeriveSubaddress(spendPubKey[:31], viewSecKey, 0, 1); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Errorf("DeriveSubaddress(31-byte spend key) error = %v, want ErrInvalidPublicKey", err)
+	}
+	if _, err := monero.DeriveSubaddress(spendPubKey, viewSecKey[:31], 0, 1); !errors.Is(err, ErrInvalidPrivateKey) {
+		t.Errorf("DeriveSubaddress(31-byte view key) error = %v, want ErrInvalidPrivateKey", err)
+	}
+	if _, err := monero.DeriveSubaddress(spendPubKey, bytes.Repeat([]byte{0xff}, 32), 0, 1); !errors.Is(err, ErrInvalidPrivateKey) {
+		t.Errorf("DeriveSubaddress(unreduced view key) error = %v, want ErrInvalidPrivateKey", err)
+	}
+	if _, err := monero.GenerateSubaddress(spendPubKey, spendPubKey[:31]); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Errorf("GenerateSubaddress(31-byte view key) error = %v, want ErrInvalidPublicKey", err)
+	}
+}
+
+// TestNewChainsDefaultFactory tests that new chains work through the package-level helpers
+func TestNewChainsDefaultFactory(t *testing.T) {
+	// Monero general fund donation address
+	knownXMR := "44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3A"
+	if !Validate(ChainMonero, knownXMR) {
+		t.Errorf("Validate(ChainMonero, %s) = false, want true", knownXMR)
+	}
+
+	pubKey, _ := hex.DecodeString("0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798")
+	for _, chainID := range []ChainID{ChainZcash, ChainKaspa, ChainStacks} {
+		addr, err := Generate(chainID, pubKey)
+		if err != nil {
+			t.Errorf("Generate(%s) error = %v", chainID, err)
+			continue
+		}
+		if !Validate(chainID, addr) {
+			t.Errorf("Validate(%s, %s) = false, want true", chainID, addr)
+		}
+	}
+}
+
 // TestNewChainsFactory tests that all new chains are registered in the factory
 func TestNewChainsFactory(t *testing.T) {
 	factory := NewFactory()
@@ -558,6 +1016,8 @@ func TestNewChainsFactory(t *testing.T) {
 		ChainFlow,
 		ChainArweave,
 		ChainMonero,
+		ChainNeo,
+		ChainQtum,
 	}
 
 	for _, chainID := range chains {
@@ -571,3 +1031,304 @@ func TestNewChainsFactory(t *testing.T) {
 		}
 	}
 }
+
+// TestNeoAddress tests NEO legacy address generation against the neon-js test account
+func TestNeoAddress(t *testing.T) {
+	neo := NewNeoAddress()
+
+	pubKey, _ := hex.DecodeString("031a6c6fbbdf02ca351745fa86b9ba5a9452d785ac4f7fc2b7548ca2a46c4fcf4a")
+	addr, err := neo.Generate(pubKey)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if want := "AK2nJJpJr6o664CWJKi1QRXjqeic2zRp8y"; addr != want {
+		t.Errorf("Generate() = %s, want %s", addr, want)
+	}
+	if !neo.Validate(addr) {
+		t.Error("Address validation failed")
+	}
+
+	script, _ := neo.VerificationScript(pubKey)
+	info, err := neo.DecodeAddress(addr)
+	if err != nil {
+		t.Fatalf("DecodeAddress() error = %v", err)
+	}
+	if hex.EncodeToString(info.PublicKey) != hex.EncodeToString(Hash160(script)) {
+		t.Errorf("DecodeAddress() script hash = %x, want %x", info.PublicKey, Hash160(script))
+	}
+
+	// A Bitcoin address has the wrong version byte
+	if neo.Validate("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH") {
+		t.Error("Should reject Bitcoin address")
+	}
+	if _, err := neo.Generate(pubKey[1:]); err == nil {
+		t.Error("Generate(32 bytes) error = nil, want error")
+	}
+}
+
+// TestQtumAddress tests Qtum P2PKH and native SegWit addresses
+func TestQtumAddress(t *testing.T) {
+	qtum := NewQtumAddress(false)
+
+	// Public key of private key 1, HASH160 751e76e8199196d454941c45d1b3a323f1433bd6
+	pubKey, _ := hex.DecodeString("0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798")
+
+	addr, err := qtum.Generate(pubKey)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if addr != "QXHFfTBKYXjaaTH1e7Rox8CcdNPGHVhM59" {
+		t.Errorf("Generate() = %s, want QXHFfTBKYXjaaTH1e7Rox8CcdNPGHVhM59", addr)
+	}
+	version, payload, err := Base58CheckDecode(addr)
+	if err != nil || version != QtumP2PKHVersion || hex.EncodeToString(payload) != "751e76e8199196d454941c45d1b3a323f1433bd6" {
+		t.Errorf("Base58CheckDecode(%s) = %#x, %x, %v", addr, version, payload, err)
+	}
+
+	segwit, err := qtum.P2WPKH(pubKey)
+	if err != nil {
+		t.Fatalf("P2WPKH() error = %v", err)
+	}
+	if segwit != "qc1qw508d6qejxtdg4y5r3zarvary0c5xw7kq52at0" {
+		t.Errorf("P2WPKH() = %s, want qc1qw508d6qejxtdg4y5r3zarvary0c5xw7kq52at0", segwit)
+	}
+
+	for _, a := range []string{addr, segwit} {
+		if !qtum.Validate(a) {
+			t.Errorf("Validate(%s) = false, want true", a)
+		}
+		if NewQtumAddress(true).Validate(a) {
+			t.Errorf("testnet Validate(%s) = true, want false", a)
+		}
+	}
+	if got, err := DetectChain(segwit); err != nil || got != ChainQtum {
+		t.Errorf("DetectChain(%s) = %s, %v, want %s", segwit, got, err, ChainQtum)
+	}
+
+	// Bitcoin addresses use other version bytes and HRPs
+	if qtum.Validate("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH") || qtum.Validate("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4") {
+		t.Error("Should reject Bitcoin addresses")
+	}
+}
+
+// TestIOTAAddress tests IOTA/Shimmer Stardust address generation
+func TestIOTAAddress(t *testing.T) {
+	// Test vector from TIP-31 (Bech32 address format)
+	pubKey, _ := hex.DecodeString("6f1581709bb7b1ef030d210db18e3b0ba1c776fba65d8cdaad05415142d189f8")
+
+	gen := NewIOTAAddress()
+	addr, err := gen.Generate(pubKey)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	expected := "iota1qrhacyfwlcnzkvzteumekfkrrwks98mpdm37cj4xx3drvmjvnep6xqgyzyx"
+	if addr != expected {
+		t.Errorf("Generate() = %s, want %s", addr, expected)
+	}
+	if !gen.Validate(addr) {
+		t.Errorf("Validate(%s) = false, want true", addr)
+	}
+
+	smr := NewShimmerAddress()
+	smrAddr, err := smr.Generate(pubKey)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if smrAddr != "smr1qrhacyfwlcnzkvzteumekfkrrwks98mpdm37cj4xx3drvmjvnep6xhcazjh" {
+		t.Errorf("Generate() = %s, want smr1qrhacyfwlcnzkvzteumekfkrrwks98mpdm37cj4xx3drvmjvnep6xhcazjh", smrAddr)
+	}
+
+	// HRP must match the network
+	if gen.Validate(smrAddr) {
+		t.Error("IOTA generator should reject Shimmer address")
+	}
+
+	info, err := gen.DecodeAddress(addr)
+	if err != nil {
+		t.Fatalf("DecodeAddress() error = %v", err)
+	}
+	if hex.EncodeToString(info.PublicKey) != "efdc112efe262b304bcf379b26c31bad029f616ee3ec4aa6345a366e4c9e43a3" {
+		t.Errorf("DecodeAddress() hash = %x", info.PublicKey)
+	}
+
+	if _, err := gen.Generate(pubKey[:31]); err == nil {
+		t.Error("Generate() should fail for 31-byte key")
+	}
+}
+
+// TestWavesAddress tests Waves address generation and validation
+func TestWavesAddress(t *testing.T) {
+	waves := NewWavesAddress()
+
+	// Known mainnet address
+	known := "3PAWwWa6GbwcJaFzwqXQN5KQm7H96Y7SHTQ"
+	if !waves.Validate(known) {
+		t.Errorf("Validate(%s) = false, want true", known)
+	}
+
+	// Changing one character breaks the checksum
+	if waves.Validate("3PAWwWa6GbwcJaFzwqXQN5KQm7H96Y7SHTR") {
+		t.Error("Validate() should reject address with bad checksum")
+	}
+
+	pubKeyHex := "a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed"
+	pubKey, _ := hex.DecodeString(pubKeyHex)
+
+	addr, err := waves.Generate(pubKey)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if !strings.HasPrefix(addr, "3P") || len(addr) != 35 {
+		t.Errorf("Generate() = %s, want 35-char address starting with '3P'", addr)
+	}
+	if !waves.Validate(addr) {
+		t.Errorf("Validate(%s) = false, want true", addr)
+	}
+
+	// Example account from the Waves cryptographic practical details docs
+	docsKey, _ := Base58Decode("HBqhfdFASRQ5eBBpu2y6c6KKi1az6bMx8v1JxX4iW1Q8")
+	if docsAddr, err := waves.Generate(docsKey); err != nil || docsAddr != "3PPbMwqLtwBGcJrTA5whqJfY95GqnNnFMDX" {
+		t.Errorf("Generate(HBqhfd...) = %s, %v, want 3PPbMwqLtwBGcJrTA5whqJfY95GqnNnFMDX", docsAddr, err)
+	}
+
+	// Testnet addresses start with '3M'/'3N' and are rejected by the mainnet generator
+	testnet := NewWavesAddressWithChainID(WavesTestnetChainID)
+	testnetAddr, err := testnet.Generate(pubKey)
+	if err != nil {
+		t.Fatalf("Generate() testnet error = %v", err)
+	}
+	if !strings.HasPrefix(testnetAddr, "3M") && !strings.HasPrefix(testnetAddr, "3N") {
+		t.Errorf("testnet address = %s, want prefix '3M' or '3N'", testnetAddr)
+	}
+	if waves.Validate(testnetAddr) {
+		t.Error("mainnet generator should reject testnet address")
+	}
+
+	if _, err := waves.Generate(pubKey[:31]); err == nil {
+		t.Error("Generate() should fail for 31-byte key")
+	}
+}
+
+// TestCKBAddress tests Nervos CKB full-format address generation
+func TestCKBAddress(t *testing.T) {
+	// CKB's default hash of empty input
+	if got := hex.EncodeToString(ckbHash(nil)); got != "44f4c69744d5f8c55d642062949dcae49bc4e7ef43d388c5a12f42b5633d163e" {
+		t.Errorf("ckbHash(nil) = %s", got)
+	}
+
+	ckb := NewCKBAddress()
+
+	// Test vector from RFC 0021 (full format, secp256k1-blake160 lock)
+	args, _ := hex.DecodeString("b39bbc0b3673c7d36450bc14cfcdad2d559c6c64")
+	addr, err := ckb.GenerateFromScript(CKBSecp256k1Blake160CodeHash, CKBHashTypeType, args)
+	if err != nil {
+		t.Fatalf("GenerateFromScript() error = %v", err)
+	}
+
+	expected := "ckb1qzda0cr08m85hc8jlnfp3zer7xulejywt49kt2rr0vthywaa50xwsqdnnw7qkdnnclfkg59uzn8umtfd2kwxceqxwquc4"
+	if addr != expected {
+		t.Errorf("GenerateFromScript() = %s, want %s", addr, expected)
+	}
+	if !ckb.Validate(addr) || !ckb.IsSecp256k1Blake160(addr) {
+		t.Errorf("Validate(%s) = false, want true", addr)
+	}
+
+	info, err := ckb.DecodeAddress(addr)
+	if err != nil {
+		t.Fatalf("DecodeAddress() error = %v", err)
+	}
+	if hex.EncodeToString(info.PublicKey) != hex.EncodeToString(args) {
+		t.Errorf("DecodeAddress() args = %x, want %x", info.PublicKey, args)
+	}
+
+	pubKey, _ := hex.DecodeString("0279BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798")
+	pkAddr, err := ckb.Generate(pubKey)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if !strings.HasPrefix(pkAddr, "ckb1") || !ckb.Validate(pkAddr) {
+		t.Errorf("Generate() = %s, want valid ckb1 address", pkAddr)
+	}
+
+	// Testnet addresses are rejected by the mainnet generator
+	testnetAddr, _ := NewCKBTestnetAddress().Generate(pubKey)
+	if !strings.HasPrefix(testnetAddr, "ckt1") || ckb.Validate(testnetAddr) {
+		t.Errorf("unexpected testnet handling for %s", testnetAddr)
+	}
+}
+
+// TestKadenaAddress tests Kadena k: account generation and validation
+func TestKadenaAddress(t *testing.T) {
+	kda := NewKadenaAddress()
+
+	pubKeyHex := "a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed"
+	pubKey, _ := hex.DecodeString(pubKeyHex)
+
+	addr, err := kda.Generate(pubKey)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if addr != "k:"+pubKeyHex {
+		t.Errorf("Generate() = %s, want k:%s", addr, pubKeyHex)
+	}
+
+	parsed, err := kda.ParseAccount(addr)
+	if err != nil {
+		t.Fatalf("ParseAccount() error = %v", err)
+	}
+	if hex.EncodeToString(parsed) != pubKeyHex {
+		t.Errorf("ParseAccount() = %x, want %s", parsed, pubKeyHex)
+	}
+
+	info, err := kda.DecodeAddress(addr)
+	if err != nil {
+		t.Fatalf("DecodeAddress() error = %v", err)
+	}
+	if info.Type != AddressTypeHex || !bytes.Equal(info.PublicKey, pubKey) {
+		t.Errorf("DecodeAddress() = %s %x, want hex %s", info.Type, info.PublicKey, pubKeyHex)
+	}
+
+	invalid := []string{
+		pubKeyHex,                         // missing prefix
+		"w:" + pubKeyHex,                  // wrong prefix
+		"k:" + pubKeyHex[:62],             // too short
+		"k:" + pubKeyHex + "00",           // too long
+		"k:" + strings.ToUpper(pubKeyHex), // not canonical lowercase
+		"k:" + strings.Repeat("zz", 32),   // not hex
+	}
+	for _, a := range invalid {
+		if kda.Validate(a) {
+			t.Errorf("Validate(%s) = true, want false", a)
+		}
+	}
+
+	if _, err := kda.Generate(pubKey[:31]); err == nil {
+		t.Error("Generate() should fail for 31-byte key")
+	}
+}
+
+func TestEVMLayer2Chains(t *testing.T) {
+	pubKey, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	want := "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf"
+
+	for _, chainID := range []ChainID{ChainBase, ChainZkSync, ChainLinea, ChainScroll} {
+		addr, err := Generate(chainID, pubKey)
+		if err != nil {
+			t.Fatalf("Generate(%s) error = %v", chainID, err)
+		}
+		if addr != want {
+			t.Errorf("Generate(%s) = %s, want %s", chainID, addr, want)
+		}
+		if !NewEVMAddress(chainID).ValidateStrict(addr) {
+			t.Errorf("ValidateStrict(%s) = false", addr)
+		}
+
+		if info := GetChainInfo(chainID); info == nil || info.ID != chainID {
+			t.Errorf("GetChainInfo(%s) = %v", chainID, info)
+		}
+		if _, ok := EVMNetworkIDs[chainID]; !ok {
+			t.Errorf("EVMNetworkIDs missing %s", chainID)
+		}
+	}
+}
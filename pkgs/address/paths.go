@@ -0,0 +1,116 @@
+package address
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BIP-32 purposes of the Bitcoin script types
+const (
+	PurposeBIP44 uint32 = 44 // P2PKH
+	PurposeBIP49 uint32 = 49 // P2SH-P2WPKH
+	PurposeBIP84 uint32 = 84 // P2WPKH
+	PurposeBIP86 uint32 = 86 // P2TR
+)
+
+// ChainCoinTypes maps chains to the SLIP-44 coin type their wallets derive
+// under. EVM chains that share Ethereum's key space use Ethereum's 60.
+var ChainCoinTypes = map[ChainID]uint32{
+	ChainBitcoin:         0,
+	ChainLitecoin:        2,
+	ChainDogecoin:        3,
+	ChainEthereum:        60,
+	ChainEthereumClassic: 61,
+	ChainKaspa:           111111,
+	ChainZcash:           133,
+	ChainBSC:             60,
+	ChainFantom:          60,
+	ChainOptimism:        60,
+	ChainArbitrum:        60,
+	ChainBase:            60,
+	ChainZkSync:          60,
+	ChainLinea:           60,
+	ChainScroll:          60,
+	ChainCosmos:          118,
+	ChainSei:             118,
+	ChainRipple:          144,
+	ChainBitcoinCash:     145,
+	ChainStellar:         148,
+	ChainEOS:             194,
+	ChainTron:            195,
+	ChainCKB:             309,
+	ChainFilecoin:        461,
+	ChainTheta:           500,
+	ChainSolana:          501,
+	ChainBinanceBEP2:     714,
+	ChainVeChain:         818,
+	ChainPolygon:         966,
+	ChainQtum:            2301,
+	ChainStacks:          5757,
+	ChainAvalanche:       9000,
+}
+
+// BitcoinScriptType normalizes a Bitcoin script type name, accepting the
+// BitcoinScript constants and the common aliases "legacy", "nested",
+// "segwit", "bech32" and "taproot". An empty name is P2PKH, the default
+// Bitcoin address format.
+func BitcoinScriptType(name string) (string, error) {
+	switch strings.ToLower(name) {
+	case "", BitcoinScriptP2PKH, "legacy":
+		return BitcoinScriptP2PKH, nil
+	case BitcoinScriptP2SHP2WPKH, "nested":
+		return BitcoinScriptP2SHP2WPKH, nil
+	case BitcoinScriptP2WPKH, "segwit", "bech32":
+		return BitcoinScriptP2WPKH, nil
+	case BitcoinScriptP2TR, "taproot":
+		return BitcoinScriptP2TR, nil
+	default:
+		return "", fmt.Errorf("unknown Bitcoin script type %q", name)
+	}
+}
+
+// bitcoinPurposes maps each script type to the purpose of its derivation standard
+var bitcoinPurposes = map[string]uint32{
+	BitcoinScriptP2PKH:      PurposeBIP44,
+	BitcoinScriptP2SHP2WPKH: PurposeBIP49,
+	BitcoinScriptP2WPKH:     PurposeBIP84,
+	BitcoinScriptP2TR:       PurposeBIP86,
+}
+
+// DerivationPath returns the path reference wallets use for an account and
+// address index on a chain, e.g. "m/84'/0'/0'/0/5" for Bitcoin P2WPKH.
+// scriptType selects the Bitcoin derivation standard and must be empty for
+// other chains. Ed25519 chains use their SLIP-10 scheme, and index must be
+// zero for schemes that enumerate accounts.
+func DerivationPath(chainID ChainID, scriptType string, account, index uint32) (string, error) {
+	purpose := PurposeBIP44
+	if chainID == ChainBitcoin {
+		script, err := BitcoinScriptType(scriptType)
+		if err != nil {
+			return "", err
+		}
+		purpose = bitcoinPurposes[script]
+	} else if scriptType != "" {
+		return "", fmt.Errorf("%w: script type %q on %s", ErrUnsupportedChain, scriptType, chainID)
+	}
+
+	if ChainCurves[chainID] == CurveEd25519 {
+		scheme, err := Ed25519DerivationScheme(chainID)
+		if err != nil {
+			return "", err
+		}
+		return scheme.PathString(account, index)
+	}
+
+	coinType, ok := ChainCoinTypes[chainID]
+	if !ok {
+		return "", fmt.Errorf("%w: no coin type for %s", ErrUnsupportedChain, chainID)
+	}
+	return fmt.Sprintf("m/%d'/%d'/%d'/0/%d", purpose, coinType, account, index), nil
+}
+
+// DefaultPath returns the path of the first receiving address of the first
+// account on a chain, e.g. "m/44'/60'/0'/0/0" for Ethereum.
+func DefaultPath(chainID ChainID, scriptType string) (string, error) {
+	return DerivationPath(chainID, scriptType, 0, 0)
+}
@@ -1,9 +1,5 @@
 package address
 
-import (
-	"fmt"
-)
-
 // SS58 network prefixes
 const (
 	SS58Polkadot  byte = 0  // Polkadot mainnet
@@ -33,16 +29,25 @@ func NewSS58Address(prefix byte, chainID ChainID) *PolkadotAddress {
 	return &PolkadotAddress{networkPrefix: prefix, chainID: chainID}
 }
 
+func init() {
+	registerChain(ChainPolkadot, func() AddressGenerator { return NewPolkadotAddress() }, nil)
+}
+
 // ChainID returns the chain identifier
 func (p *PolkadotAddress) ChainID() ChainID {
 	return p.chainID
 }
 
+// Info describes the chain
+func (p *PolkadotAddress) Info() ChainInfo {
+	return ChainInfo{ChainPolkadot, "Polkadot", "DOT", "SS58", "Network-specific prefixes"}
+}
+
 // Generate creates an SS58 address from a public key
 // Public key should be 32 bytes (Sr25519 or Ed25519)
 func (p *PolkadotAddress) Generate(publicKey []byte) (string, error) {
 	if len(publicKey) != 32 {
-		return "", fmt.Errorf("Polkadot requires 32-byte public key, got %d bytes", len(publicKey))
+		return "", keyLengthError(p.ChainID(), len(publicKey), 32)
 	}
 
 	// SS58 format:
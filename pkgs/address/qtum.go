@@ -0,0 +1,124 @@
+package address
+
+// Qtum address version bytes
+const (
+	// Mainnet
+	QtumP2PKHVersion byte = 0x3A // Prefix: Q
+	QtumP2SHVersion  byte = 0x32 // Prefix: M
+	QtumBech32HRP         = "qc"
+
+	// Testnet
+	QtumTestnetP2PKHVersion byte = 0x78 // Prefix: q
+	QtumTestnetP2SHVersion  byte = 0x6E // Prefix: m
+	QtumTestnetBech32HRP         = "tq"
+)
+
+// QtumAddress generates Qtum addresses
+type QtumAddress struct {
+	testnet bool
+}
+
+// NewQtumAddress creates a new Qtum address generator
+func NewQtumAddress(testnet bool) *QtumAddress {
+	return &QtumAddress{testnet: testnet}
+}
+
+func init() {
+	registerChain(ChainQtum,
+		func() AddressGenerator { return NewQtumAddress(false) },
+		func() AddressGenerator { return NewQtumAddress(true) })
+}
+
+// ChainID returns the chain identifier
+func (q *QtumAddress) ChainID() ChainID {
+	return ChainQtum
+}
+
+// Info describes the chain
+func (q *QtumAddress) Info() ChainInfo {
+	return ChainInfo{ChainQtum, "Qtum", "QTUM", "Base58Check/Bech32", "Starts with 'Q' or 'qc1'"}
+}
+
+// P2PKH generates a Pay-to-Public-Key-Hash address (starts with Q on mainnet)
+func (q *QtumAddress) P2PKH(publicKey []byte) (string, error) {
+	if len(publicKey) != 33 && len(publicKey) != 65 {
+		return "", keyLengthError(q.ChainID(), len(publicKey), 33, 65)
+	}
+
+	pubKeyHash := Hash160(publicKey)
+
+	version := QtumP2PKHVersion
+	if q.testnet {
+		version = QtumTestnetP2PKHVersion
+	}
+
+	return Base58CheckEncode(version, pubKeyHash), nil
+}
+
+// P2SH generates a Pay-to-Script-Hash address (starts with M on mainnet)
+func (q *QtumAddress) P2SH(redeemScript []byte) (string, error) {
+	if len(redeemScript) == 0 {
+		return "", ErrInvalidPublicKey
+	}
+
+	scriptHash := Hash160(redeemScript)
+
+	version := QtumP2SHVersion
+	if q.testnet {
+		version = QtumTestnetP2SHVersion
+	}
+
+	return Base58CheckEncode(version, scriptHash), nil
+}
+
+// P2WPKH generates a native SegWit address (starts with qc1q on mainnet)
+func (q *QtumAddress) P2WPKH(publicKey []byte) (string, error) {
+	if len(publicKey) != 33 {
+		return "", keyLengthError(q.ChainID(), len(publicKey), 33)
+	}
+
+	pubKeyHash := Hash160(publicKey)
+
+	hrp := QtumBech32HRP
+	if q.testnet {
+		hrp = QtumTestnetBech32HRP
+	}
+
+	return SegWitEncode(hrp, 0, pubKeyHash)
+}
+
+// Generate creates a P2PKH address by default
+func (q *QtumAddress) Generate(publicKey []byte) (string, error) {
+	return q.P2PKH(publicKey)
+}
+
+// Validate checks if an address is valid
+func (q *QtumAddress) Validate(address string) bool {
+	// Check for Bech32 addresses
+	if hrp, ok := HRPOf(address); ok && (hrp == QtumBech32HRP || hrp == QtumTestnetBech32HRP) {
+		if _, _, _, err := SegWitDecode(address); err != nil {
+			return false
+		}
+
+		expected := QtumBech32HRP
+		if q.testnet {
+			expected = QtumTestnetBech32HRP
+		}
+		return hrp == expected
+	}
+
+	// Check for Base58Check addresses
+	version, _, err := Base58CheckDecode(address)
+	if err != nil {
+		return false
+	}
+
+	switch version {
+	case QtumP2PKHVersion, QtumP2SHVersion:
+		return !q.testnet
+	case QtumTestnetP2PKHVersion, QtumTestnetP2SHVersion:
+		return q.testnet
+	}
+
+	return false
+}
@@ -1,7 +1,10 @@
 package address
 
 import (
+	"crypto/subtle"
 	"fmt"
+
+	"github.com/study/crypto-accounts/pkgs/crypto/encoding"
 )
 
 // Ripple address version
@@ -9,8 +12,9 @@ const (
 	RippleAccountPrefix byte = 0x00 // Addresses start with 'r'
 )
 
-// Ripple-specific Base58 encoder
-var rippleBase58 = NewBase58Encoder(RippleAlphabet)
+// RippleEd25519KeyPrefix marks a 33-byte XRP Ledger public key as Ed25519,
+// distinguishing it from compressed secp256k1 keys (0x02 or 0x03)
+const RippleEd25519KeyPrefix byte = 0xED
 
 // RippleAddress generates Ripple (XRP) addresses
 type RippleAddress struct{}
@@ -20,16 +24,31 @@ func NewRippleAddress() *RippleAddress {
 	return &RippleAddress{}
 }
 
+func init() {
+	registerChain(ChainRipple, func() AddressGenerator { return NewRippleAddress() }, nil)
+}
+
 // ChainID returns the chain identifier
 func (r *RippleAddress) ChainID() ChainID {
 	return ChainRipple
 }
 
+// Info describes the chain
+func (r *RippleAddress) Info() ChainInfo {
+	return ChainInfo{ChainRipple, "Ripple", "XRP", "Base58 (Ripple)", "Starts with 'r'"}
+}
+
 // Generate creates a Ripple address from a public key
-// Public key should be 33 bytes (compressed secp256k1)
+// Public key should be 33 bytes: compressed secp256k1, or an Ed25519 key
+// prefixed with 0xED as the XRP Ledger encodes it
 func (r *RippleAddress) Generate(publicKey []byte) (string, error) {
 	if len(publicKey) != 33 {
-		return "", fmt.Errorf("Ripple requires 33-byte compressed public key, got %d bytes", len(publicKey))
+		return "", keyLengthError(r.ChainID(), len(publicKey), 33)
+	}
+	switch publicKey[0] {
+	case 0x02, 0x03, RippleEd25519KeyPrefix:
+	default:
+		return "", fmt.Errorf("%w: unknown XRP key prefix 0x%02x", ErrInvalidPublicKey, publicKey[0])
 	}
 
 	// 1. SHA256 then RIPEMD160 to create Account ID
@@ -46,7 +65,17 @@ func (r *RippleAddress) Generate(publicKey []byte) (string, error) {
 	// 4. Append checksum and encode with Ripple's Base58
 	final := append(payload, checksum...)
 
-	return rippleBase58.Encode(final), nil
+	return encoding.Base58EncodeWithAlphabet(final, RippleAlphabet)
+}
+
+// GenerateEd25519 creates a Ripple address from a raw 32-byte Ed25519 public
+// key, the default key type of modern XRP wallets
+func (r *RippleAddress) GenerateEd25519(publicKey []byte) (string, error) {
+	if len(publicKey) != 32 {
+		return "", keyLengthError(r.ChainID(), len(publicKey), 32)
+	}
+
+	return r.Generate(append([]byte{RippleEd25519KeyPrefix}, publicKey...))
 }
 
 // Validate checks if a Ripple address is valid
@@ -56,7 +85,7 @@ func (r *RippleAddress) Validate(address string) bool {
 		return false
 	}
 
-	decoded, err := rippleBase58.Decode(address)
+	decoded, err := encoding.Base58DecodeWithAlphabet(address, RippleAlphabet)
 	if err != nil {
 		return false
 	}
@@ -75,10 +104,8 @@ func (r *RippleAddress) Validate(address string) bool {
 	checksum := decoded[21:]
 	expectedChecksum := DoubleSHA256(payload)[:4]
 
-	for i := 0; i < 4; i++ {
-		if checksum[i] != expectedChecksum[i] {
-			return false
-		}
+	if subtle.ConstantTimeCompare(checksum, expectedChecksum) != 1 {
+		return false
 	}
 
 	return true
@@ -90,7 +117,7 @@ func (r *RippleAddress) DecodeAddress(address string) (*AddressInfo, error) {
 		return nil, ErrInvalidAddress
 	}
 
-	decoded, _ := rippleBase58.Decode(address)
+	decoded, _ := encoding.Base58DecodeWithAlphabet(address, RippleAlphabet)
 
 	return &AddressInfo{
 		Address:   address,
@@ -0,0 +1,123 @@
+package address
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/study/crypto-accounts/pkgs/crypto/secp256k1"
+)
+
+// BIP-352 silent payment address parameters
+const (
+	SilentPaymentHRP        = "sp"
+	SilentPaymentTestnetHRP = "tsp"
+
+	// silentPaymentMaxLength is the Bech32m length limit BIP-352 raises from 90
+	silentPaymentMaxLength = 1023
+
+	// silentPaymentKeysLen is the scan and spend keys, both compressed
+	silentPaymentKeysLen = 2 * secp256k1.CompressedPubKeyLen
+)
+
+// SilentPaymentAddress encodes BIP-352 silent payment addresses (sp1q...).
+// Unlike other addresses they carry two public keys: senders combine the
+// scan key with their inputs to derive a fresh output for every payment,
+// so the address itself never appears on chain.
+type SilentPaymentAddress struct {
+	testnet bool
+}
+
+// NewSilentPaymentAddress creates a new silent payment address encoder
+func NewSilentPaymentAddress(testnet bool) *SilentPaymentAddress {
+	return &SilentPaymentAddress{testnet: testnet}
+}
+
+// hrp returns the human-readable part for the encoder's network
+func (s *SilentPaymentAddress) hrp() string {
+	if s.testnet {
+		return SilentPaymentTestnetHRP
+	}
+	return SilentPaymentHRP
+}
+
+// Generate encodes a version 0 silent payment address from the 33-byte
+// compressed scan and spend public keys.
+func (s *SilentPaymentAddress) Generate(scanPubKey, spendPubKey []byte) (string, error) {
+	for _, key := range [][]byte{scanPubKey, spendPubKey} {
+		if _, err := secp256k1.DecompressPoint(key); err != nil {
+			return "", fmt.Errorf("%w: silent payment keys must be 33-byte compressed", ErrInvalidPublicKey)
+		}
+	}
+
+	keys := make([]byte, 0, silentPaymentKeysLen)
+	keys = append(keys, scanPubKey...)
+	keys = append(keys, spendPubKey...)
+	converted, err := ConvertBitsBytes(keys, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	data := append([]int{0}, converted...)
+	checksum := bech32CreateChecksum(s.hrp(), data, Bech32m)
+
+	result := strings.Builder{}
+	result.WriteString(s.hrp())
+	result.WriteByte('1')
+	for _, d := range append(data, checksum...) {
+		result.WriteByte(bech32Charset[d])
+	}
+
+	return result.String(), nil
+}
+
+// Decode splits a silent payment address into its scan and spend public keys.
+// Per BIP-352, version 0 carries exactly the two keys, later versions may
+// append data that version 0 readers ignore, and version 31 is reserved.
+func (s *SilentPaymentAddress) Decode(address string) (scanPubKey, spendPubKey []byte, err error) {
+	if len(address) > silentPaymentMaxLength {
+		return nil, nil, fmt.Errorf("%w: silent payment address exceeds %d characters", ErrInvalidAddress, silentPaymentMaxLength)
+	}
+
+	hrp, data, encoding, err := bech32DecodeGroups(address)
+	if err != nil {
+		return nil, nil, err
+	}
+	if hrp != s.hrp() {
+		return nil, nil, fmt.Errorf("%w: prefix %q, want %q", ErrNetworkMismatch, hrp, s.hrp())
+	}
+	if encoding != Bech32m || len(data) < 1 {
+		return nil, nil, ErrInvalidAddress
+	}
+
+	version := data[0]
+	if version == 31 {
+		return nil, nil, fmt.Errorf("%w: silent payment version 31", ErrInvalidVersion)
+	}
+
+	converted, err := convertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(converted) < silentPaymentKeysLen || (version == 0 && len(converted) != silentPaymentKeysLen) {
+		return nil, nil, fmt.Errorf("%w: %d bytes of key data", ErrInvalidAddress, len(converted))
+	}
+
+	keys := make([]byte, silentPaymentKeysLen)
+	for i := range keys {
+		keys[i] = byte(converted[i])
+	}
+	scanPubKey, spendPubKey = keys[:secp256k1.CompressedPubKeyLen], keys[secp256k1.CompressedPubKeyLen:]
+	for _, key := range [][]byte{scanPubKey, spendPubKey} {
+		if _, err := secp256k1.DecompressPoint(key); err != nil {
+			return nil, nil, ErrInvalidPublicKey
+		}
+	}
+
+	return scanPubKey, spendPubKey, nil
+}
+
+// Validate checks if a silent payment address is valid for the encoder's network
+func (s *SilentPaymentAddress) Validate(address string) bool {
+	_, _, err := s.Decode(address)
+	return err == nil
+}
@@ -2,6 +2,8 @@ package address
 
 import (
 	"fmt"
+
+	"github.com/study/crypto-accounts/pkgs/crypto/ed25519"
 )
 
 // SolanaAddress generates Solana addresses
@@ -13,16 +15,25 @@ func NewSolanaAddress() *SolanaAddress {
 	return &SolanaAddress{}
 }
 
+func init() {
+	registerChain(ChainSolana, func() AddressGenerator { return NewSolanaAddress() }, nil)
+}
+
 // ChainID returns the chain identifier
 func (s *SolanaAddress) ChainID() ChainID {
 	return ChainSolana
 }
 
+// Info describes the chain
+func (s *SolanaAddress) Info() ChainInfo {
+	return ChainInfo{ChainSolana, "Solana", "SOL", "Base58", "32-byte public key"}
+}
+
 // Generate creates a Solana address from a public key
 // Public key should be 32 bytes (Ed25519 public key)
 func (s *SolanaAddress) Generate(publicKey []byte) (string, error) {
 	if len(publicKey) != 32 {
-		return "", fmt.Errorf("Solana requires 32-byte Ed25519 public key, got %d bytes", len(publicKey))
+		return "", keyLengthError(s.ChainID(), len(publicKey), 32)
 	}
 
 	// Solana addresses are simply Base58-encoded public keys
@@ -40,6 +51,55 @@ func (s *SolanaAddress) Validate(address string) bool {
 	return len(decoded) == 32
 }
 
+// IsOnCurve reports whether a Solana address is a valid Ed25519 point.
+// Wallet addresses are on-curve public keys; program-derived addresses (PDAs)
+// are deliberately off-curve so no private key exists for them.
+func (s *SolanaAddress) IsOnCurve(address string) bool {
+	decoded, err := Base58Decode(address)
+	if err != nil || len(decoded) != 32 {
+		return false
+	}
+
+	_, err = ed25519.DecodePoint(decoded)
+	return err == nil
+}
+
+// SignMessage signs msg with a 32-byte Ed25519 private key (seed), returning
+// the 64-byte signature Solana uses for transactions and off-chain messages
+func (s *SolanaAddress) SignMessage(privKey, msg []byte) ([]byte, error) {
+	return ed25519.Sign(privKey, msg)
+}
+
+// VerifyMessage checks a 64-byte Ed25519 signature of msg against a Solana address
+func (s *SolanaAddress) VerifyMessage(address string, msg, signature []byte) bool {
+	publicKey, err := Base58Decode(address)
+	if err != nil {
+		return false
+	}
+
+	return ed25519.Verify(publicKey, msg, signature)
+}
+
+// EncodeSolanaSignature encodes a signature as Base58, as Solana explorers and RPCs display it
+func EncodeSolanaSignature(signature []byte) (string, error) {
+	if len(signature) != ed25519.SignatureSize {
+		return "", ed25519.ErrInvalidSignature
+	}
+	return Base58Encode(signature), nil
+}
+
+// DecodeSolanaSignature decodes a Base58 Solana signature
+func DecodeSolanaSignature(signature string) ([]byte, error) {
+	decoded, err := Base58Decode(signature)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) != ed25519.SignatureSize {
+		return nil, ed25519.ErrInvalidSignature
+	}
+	return decoded, nil
+}
+
 // DecodeAddress decodes a Solana address
 func (s *SolanaAddress) DecodeAddress(address string) (*AddressInfo, error) {
 	decoded, err := Base58Decode(address)
@@ -2,8 +2,11 @@ package address
 
 import (
 	"crypto/sha256"
+	"crypto/subtle"
 	"fmt"
 	"strings"
+
+	"github.com/study/crypto-accounts/pkgs/crypto/encoding"
 )
 
 // Stacks address version bytes
@@ -32,16 +35,27 @@ func NewStacksTestnetAddress() *StacksAddress {
 	return &StacksAddress{testnet: true}
 }
 
+func init() {
+	registerChain(ChainStacks,
+		func() AddressGenerator { return NewStacksAddress() },
+		func() AddressGenerator { return NewStacksTestnetAddress() })
+}
+
 // ChainID returns the chain identifier
 func (s *StacksAddress) ChainID() ChainID {
 	return ChainStacks
 }
 
+// Info describes the chain
+func (s *StacksAddress) Info() ChainInfo {
+	return ChainInfo{ChainStacks, "Stacks", "STX", "c32check", "Starts with 'S'"}
+}
+
 // Generate creates a Stacks address from a public key
 // Public key should be 33 bytes (compressed secp256k1)
 func (s *StacksAddress) Generate(publicKey []byte) (string, error) {
 	if len(publicKey) != 33 {
-		return "", fmt.Errorf("invalid public key length: expected 33, got %d", len(publicKey))
+		return "", keyLengthError(s.ChainID(), len(publicKey), 33)
 	}
 
 	// Hash160 = RIPEMD160(SHA256(publicKey))
@@ -181,110 +195,30 @@ func c32CheckDecode(address string) (byte, []byte, error) {
 	hash2 := sha256.Sum256(hash1[:])
 	expectedChecksum := hash2[:4]
 
-	for i := 0; i < 4; i++ {
-		if checksum[i] != expectedChecksum[i] {
-			return 0, nil, fmt.Errorf("invalid checksum")
-		}
+	if subtle.ConstantTimeCompare(checksum, expectedChecksum) != 1 {
+		return 0, nil, fmt.Errorf("invalid checksum")
 	}
 
 	return version, data, nil
 }
 
-// c32Encode encodes bytes to c32 string
+// c32Encode encodes bytes to a c32 string: the data as one big-endian number
+// in base 32, with a leading '0' for each leading zero byte
 func c32Encode(data []byte) string {
-	if len(data) == 0 {
-		return ""
-	}
-
-	// Convert bytes to base32
-	result := make([]byte, 0, len(data)*8/5+1)
-
-	var carry uint32
-	var bits uint
-
-	for _, b := range data {
-		carry = (carry << 8) | uint32(b)
-		bits += 8
-
-		for bits >= 5 {
-			bits -= 5
-			result = append(result, c32Alphabet[(carry>>bits)&0x1F])
-		}
-	}
-
-	if bits > 0 {
-		result = append(result, c32Alphabet[(carry<<(5-bits))&0x1F])
-	}
-
-	// Remove leading zeros but preserve at least one character
-	start := 0
-	for start < len(result)-1 && result[start] == '0' {
-		start++
-	}
-
-	// Add leading zeros for leading zero bytes
-	for _, b := range data {
-		if b != 0 {
-			break
-		}
-		result = append([]byte{'0'}, result...)
-	}
-
-	return string(result[start:])
+	encoded, _ := encoding.ConvertBase(data, 256, 32, c32Alphabet)
+	return string(encoded)
 }
 
-// c32Decode decodes a c32 string to bytes
+// c32Decode decodes a c32 string to bytes, accepting lowercase characters
 func c32Decode(str string) ([]byte, error) {
-	if len(str) == 0 {
-		return []byte{}, nil
-	}
-
-	// Build reverse alphabet lookup
-	alphabet := make(map[byte]byte)
-	for i := 0; i < len(c32Alphabet); i++ {
-		alphabet[c32Alphabet[i]] = byte(i)
-	}
-	// Handle lowercase
-	for i := 0; i < len(c32Alphabet); i++ {
-		if c32Alphabet[i] >= 'A' && c32Alphabet[i] <= 'Z' {
-			alphabet[c32Alphabet[i]+32] = byte(i)
-		}
-	}
-
-	// Count leading zeros
-	leadingZeros := 0
-	for _, c := range str {
-		if c == '0' {
-			leadingZeros++
-		} else {
-			break
-		}
-	}
-
-	// Convert from base32 to bytes
-	var carry uint64
-	var bits uint
-
-	result := make([]byte, 0, len(str)*5/8+1)
-
+	digits := make([]byte, len(str))
 	for i := 0; i < len(str); i++ {
-		val, ok := alphabet[str[i]]
-		if !ok {
+		idx := strings.IndexByte(c32Alphabet, strings.ToUpper(str[i : i+1])[0])
+		if idx < 0 {
 			return nil, fmt.Errorf("invalid character: %c", str[i])
 		}
-
-		carry = (carry << 5) | uint64(val)
-		bits += 5
-
-		for bits >= 8 {
-			bits -= 8
-			result = append(result, byte(carry>>bits))
-		}
+		digits[i] = byte(idx)
 	}
 
-	// Add leading zero bytes
-	zeros := make([]byte, leadingZeros)
-	result = append(zeros, result...)
-
-	return result, nil
+	return encoding.ConvertBase(digits, 32, 256, "")
 }
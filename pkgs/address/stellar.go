@@ -3,15 +3,39 @@ package address
 import (
 	"encoding/base32"
 	"fmt"
+
+	"github.com/study/crypto-accounts/pkgs/crypto/checksum"
 )
 
 // Stellar address type prefixes
 const (
-	StellarAccountPrefix byte = 6 << 3  // 'G' prefix (48)
-	StellarSeedPrefix    byte = 18 << 3 // 'S' prefix (144)
-	StellarMuxedPrefix   byte = 12 << 3 // 'M' prefix (96)
+	StellarAccountPrefix  byte = 6 << 3  // 'G' prefix (48)
+	StellarSeedPrefix     byte = 18 << 3 // 'S' prefix (144)
+	StellarMuxedPrefix    byte = 12 << 3 // 'M' prefix (96)
+	StellarContractPrefix byte = 2 << 3  // 'C' prefix (16)
+)
+
+// StellarKeyType is the kind of key a strkey encodes
+type StellarKeyType string
+
+const (
+	StellarKeyAccount  StellarKeyType = "account"  // G...: Ed25519 public key
+	StellarKeySeed     StellarKeyType = "seed"     // S...: Ed25519 secret seed
+	StellarKeyMuxed    StellarKeyType = "muxed"    // M...: account with a 64-bit ID
+	StellarKeyContract StellarKeyType = "contract" // C...: Soroban contract ID
 )
 
+// stellarKeyTypes maps strkey version bytes to key types and payload lengths
+var stellarKeyTypes = map[byte]struct {
+	keyType    StellarKeyType
+	payloadLen int
+}{
+	StellarAccountPrefix:  {StellarKeyAccount, 32},
+	StellarSeedPrefix:     {StellarKeySeed, 32},
+	StellarMuxedPrefix:    {StellarKeyMuxed, 40},
+	StellarContractPrefix: {StellarKeyContract, 32},
+}
+
 // Custom Base32 encoding for Stellar (no padding)
 var stellarBase32 = base32.StdEncoding.WithPadding(base32.NoPadding)
 
@@ -24,16 +48,25 @@ func NewStellarAddress() *StellarAddress {
 	return &StellarAddress{}
 }
 
+func init() {
+	registerChain(ChainStellar, func() AddressGenerator { return NewStellarAddress() }, nil)
+}
+
 // ChainID returns the chain identifier
 func (s *StellarAddress) ChainID() ChainID {
 	return ChainStellar
 }
 
+// Info describes the chain
+func (s *StellarAddress) Info() ChainInfo {
+	return ChainInfo{ChainStellar, "Stellar", "XLM", "Base32", "Starts with 'G'"}
+}
+
 // Generate creates a Stellar address from a public key
 // Public key should be 32 bytes (Ed25519 public key)
 func (s *StellarAddress) Generate(publicKey []byte) (string, error) {
 	if len(publicKey) != 32 {
-		return "", fmt.Errorf("Stellar requires 32-byte Ed25519 public key, got %d bytes", len(publicKey))
+		return "", keyLengthError(s.ChainID(), len(publicKey), 32)
 	}
 
 	// Create payload: version byte + public key
@@ -42,13 +75,13 @@ func (s *StellarAddress) Generate(publicKey []byte) (string, error) {
 	copy(payload[1:], publicKey)
 
 	// Calculate CRC16-XModem checksum
-	checksum := crc16XModem(payload)
+	crc := checksum.CRC16XModem(payload)
 
 	// Create final data: payload + checksum (little-endian)
 	final := make([]byte, 35)
 	copy(final, payload)
-	final[33] = byte(checksum & 0xFF)
-	final[34] = byte(checksum >> 8)
+	final[33] = byte(crc & 0xFF)
+	final[34] = byte(crc >> 8)
 
 	// Base32 encode
 	return stellarBase32.EncodeToString(final), nil
@@ -77,12 +110,37 @@ func (s *StellarAddress) Validate(address string) bool {
 
 	// Verify checksum
 	payload := decoded[:33]
-	expectedChecksum := crc16XModem(payload)
+	expectedChecksum := checksum.CRC16XModem(payload)
 	actualChecksum := uint16(decoded[33]) | uint16(decoded[34])<<8
 
 	return expectedChecksum == actualChecksum
 }
 
+// GetKeyType returns the kind of key a strkey encodes, after checking its
+// version byte, length and CRC16 checksum.
+func (s *StellarAddress) GetKeyType(strkey string) (StellarKeyType, error) {
+	decoded, err := stellarBase32.DecodeString(strkey)
+	if err != nil || len(decoded) < 3 {
+		return "", ErrInvalidAddress
+	}
+
+	kind, ok := stellarKeyTypes[decoded[0]]
+	if !ok {
+		return "", fmt.Errorf("%w: version byte 0x%02x", ErrInvalidVersion, decoded[0])
+	}
+	if len(decoded) != 1+kind.payloadLen+2 {
+		return "", fmt.Errorf("%w: %s strkey of %d bytes", ErrInvalidAddress, kind.keyType, len(decoded))
+	}
+
+	payload := decoded[:len(decoded)-2]
+	actualChecksum := uint16(decoded[len(decoded)-2]) | uint16(decoded[len(decoded)-1])<<8
+	if checksum.CRC16XModem(payload) != actualChecksum {
+		return "", ErrInvalidChecksum
+	}
+
+	return kind.keyType, nil
+}
+
 // DecodeAddress decodes a Stellar address
 func (s *StellarAddress) DecodeAddress(address string) (*AddressInfo, error) {
 	if !s.Validate(address) {
@@ -99,22 +157,3 @@ func (s *StellarAddress) DecodeAddress(address string) (*AddressInfo, error) {
 		Version:   decoded[0],
 	}, nil
 }
-
-// crc16XModem calculates CRC16-XModem checksum
-func crc16XModem(data []byte) uint16 {
-	crc := uint16(0)
-	polynomial := uint16(0x1021)
-
-	for _, b := range data {
-		crc ^= uint16(b) << 8
-		for i := 0; i < 8; i++ {
-			if crc&0x8000 != 0 {
-				crc = (crc << 1) ^ polynomial
-			} else {
-				crc <<= 1
-			}
-		}
-	}
-
-	return crc
-}
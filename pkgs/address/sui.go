@@ -22,11 +22,20 @@ func NewSuiAddress() *SuiAddress {
 	return &SuiAddress{}
 }
 
+func init() {
+	registerChain(ChainSui, func() AddressGenerator { return NewSuiAddress() }, nil)
+}
+
 // ChainID returns the chain identifier
 func (s *SuiAddress) ChainID() ChainID {
 	return ChainSui
 }
 
+// Info describes the chain
+func (s *SuiAddress) Info() ChainInfo {
+	return ChainInfo{ChainSui, "Sui", "SUI", "Hex", "0x-prefixed, 64 hex chars"}
+}
+
 // Generate creates a Sui address from an Ed25519 public key
 // Public key should be 32 bytes
 func (s *SuiAddress) Generate(publicKey []byte) (string, error) {
@@ -46,7 +55,7 @@ func (s *SuiAddress) GenerateWithScheme(publicKey []byte, flag byte) (string, er
 	}
 
 	if len(publicKey) != expectedLen {
-		return "", fmt.Errorf("invalid public key length: expected %d, got %d", expectedLen, len(publicKey))
+		return "", keyLengthError(s.ChainID(), len(publicKey), expectedLen)
 	}
 
 	// Sui address generation:
@@ -95,6 +104,6 @@ func (s *SuiAddress) DecodeAddress(address string) (*AddressInfo, error) {
 		Address:   address,
 		PublicKey: decoded,
 		ChainID:   ChainSui,
-		Type:      AddressTypeBase58, // Actually hex
+		Type:      AddressTypeHex,
 	}, nil
 }
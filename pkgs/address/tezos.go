@@ -1,6 +1,8 @@
 package address
 
 import (
+	"bytes"
+	"crypto/subtle"
 	"fmt"
 
 	"golang.org/x/crypto/blake2b"
@@ -12,11 +14,13 @@ var (
 	TezosEd25519PKHPrefix   = []byte{6, 161, 159}    // tz1
 	TezosSecp256k1PKHPrefix = []byte{6, 161, 161}    // tz2
 	TezosP256PKHPrefix      = []byte{6, 161, 164}    // tz3
+	TezosBLSPKHPrefix       = []byte{6, 161, 166}    // tz4
 
 	// Public key prefixes
 	TezosEd25519PKPrefix   = []byte{13, 15, 37, 217} // edpk
 	TezosSecp256k1PKPrefix = []byte{3, 254, 226, 86} // sppk
 	TezosP256PKPrefix      = []byte{3, 178, 139, 127} // p2pk
+	TezosBLSPKPrefix       = []byte{6, 149, 135, 204} // BLpk
 )
 
 // TezosKeyType represents the cryptographic curve used
@@ -26,8 +30,12 @@ const (
 	TezosKeyEd25519 TezosKeyType = iota
 	TezosKeySecp256k1
 	TezosKeyP256
+	TezosKeyBLS
 )
 
+// tezosBLSKeyLen is the size of a compressed BLS12-381 G1 public key
+const tezosBLSKeyLen = 48
+
 // TezosAddress generates Tezos (XTZ) addresses
 type TezosAddress struct {
 	keyType TezosKeyType
@@ -43,15 +51,25 @@ func NewTezosAddressWithKeyType(keyType TezosKeyType) *TezosAddress {
 	return &TezosAddress{keyType: keyType}
 }
 
+func init() {
+	registerChain(ChainTezos, func() AddressGenerator { return NewTezosAddress() }, nil)
+}
+
 // ChainID returns the chain identifier
 func (t *TezosAddress) ChainID() ChainID {
 	return ChainTezos
 }
 
+// Info describes the chain
+func (t *TezosAddress) Info() ChainInfo {
+	return ChainInfo{ChainTezos, "Tezos", "XTZ", "Base58Check", "Starts with 'tz'"}
+}
+
 // Generate creates a Tezos address from a public key
 // For Ed25519: 32-byte public key -> tz1 address
 // For Secp256k1: 33-byte compressed public key -> tz2 address
 // For P256: 33-byte compressed public key -> tz3 address
+// For BLS: 48-byte compressed BLS12-381 public key -> tz4 address
 func (t *TezosAddress) Generate(publicKey []byte) (string, error) {
 	var prefix []byte
 	var expectedLen int
@@ -66,12 +84,15 @@ func (t *TezosAddress) Generate(publicKey []byte) (string, error) {
 	case TezosKeyP256:
 		prefix = TezosP256PKHPrefix
 		expectedLen = 33
+	case TezosKeyBLS:
+		prefix = TezosBLSPKHPrefix
+		expectedLen = tezosBLSKeyLen
 	default:
 		return "", fmt.Errorf("unsupported key type")
 	}
 
 	if len(publicKey) != expectedLen {
-		return "", fmt.Errorf("invalid public key length for Tezos: expected %d, got %d", expectedLen, len(publicKey))
+		return "", keyLengthError(t.ChainID(), len(publicKey), expectedLen)
 	}
 
 	// Hash the public key with Blake2b-160
@@ -108,6 +129,74 @@ func (t *TezosAddress) GenerateTz3(publicKey []byte) (string, error) {
 	return Base58CheckEncodeWithPrefix(TezosP256PKHPrefix, hash), nil
 }
 
+// GenerateTz4 creates a tz4 address from a BLS12-381 public key (48 bytes compressed)
+func (t *TezosAddress) GenerateTz4(publicKey []byte) (string, error) {
+	if len(publicKey) != tezosBLSKeyLen {
+		return "", fmt.Errorf("BLS public key must be 48 bytes (compressed)")
+	}
+	hash := blake2b160(publicKey)
+	return Base58CheckEncodeWithPrefix(TezosBLSPKHPrefix, hash), nil
+}
+
+// EncodePublicKey encodes the full public key (not its hash) as Tezos
+// operations carry it: edpk (Ed25519, 32 bytes), sppk (Secp256k1, 33 bytes)
+// p2pk (P256, 33 bytes) or BLpk (BLS, 48 bytes), depending on the generator's key type
+func (t *TezosAddress) EncodePublicKey(publicKey []byte) (string, error) {
+	var prefix []byte
+	var expectedLen int
+
+	switch t.keyType {
+	case TezosKeyEd25519:
+		prefix, expectedLen = TezosEd25519PKPrefix, 32
+	case TezosKeySecp256k1:
+		prefix, expectedLen = TezosSecp256k1PKPrefix, 33
+	case TezosKeyP256:
+		prefix, expectedLen = TezosP256PKPrefix, 33
+	case TezosKeyBLS:
+		prefix, expectedLen = TezosBLSPKPrefix, tezosBLSKeyLen
+	default:
+		return "", fmt.Errorf("unsupported key type")
+	}
+
+	if len(publicKey) != expectedLen {
+		return "", keyLengthError(t.ChainID(), len(publicKey), expectedLen)
+	}
+
+	return Base58CheckEncodeWithPrefix(prefix, publicKey), nil
+}
+
+// DecodeTezosPublicKey decodes an edpk, sppk, p2pk or BLpk public key, returning its
+// key type and raw bytes
+func DecodeTezosPublicKey(encoded string) (TezosKeyType, []byte, error) {
+	decoded, err := Base58Decode(encoded)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	for _, pk := range []struct {
+		keyType TezosKeyType
+		prefix  []byte
+		keyLen  int
+	}{
+		{TezosKeyEd25519, TezosEd25519PKPrefix, 32},
+		{TezosKeySecp256k1, TezosSecp256k1PKPrefix, 33},
+		{TezosKeyP256, TezosP256PKPrefix, 33},
+		{TezosKeyBLS, TezosBLSPKPrefix, tezosBLSKeyLen},
+	} {
+		if len(decoded) != len(pk.prefix)+pk.keyLen+4 || !bytes.HasPrefix(decoded, pk.prefix) {
+			continue
+		}
+
+		payload := decoded[:len(decoded)-4]
+		if subtle.ConstantTimeCompare(decoded[len(payload):], DoubleSHA256(payload)[:4]) != 1 {
+			return 0, nil, ErrInvalidChecksum
+		}
+		return pk.keyType, payload[len(pk.prefix):], nil
+	}
+
+	return 0, nil, ErrInvalidPublicKey
+}
+
 // Validate checks if a Tezos address is valid
 func (t *TezosAddress) Validate(address string) bool {
 	// Tezos addresses are 36 characters
@@ -117,7 +206,7 @@ func (t *TezosAddress) Validate(address string) bool {
 
 	// Check prefix
 	prefix := address[:3]
-	if prefix != "tz1" && prefix != "tz2" && prefix != "tz3" {
+	if prefix != "tz1" && prefix != "tz2" && prefix != "tz3" && prefix != "tz4" {
 		return false
 	}
 
@@ -137,10 +226,8 @@ func (t *TezosAddress) Validate(address string) bool {
 	checksum := decoded[23:]
 	expectedChecksum := DoubleSHA256(payload)[:4]
 
-	for i := 0; i < 4; i++ {
-		if checksum[i] != expectedChecksum[i] {
-			return false
-		}
+	if subtle.ConstantTimeCompare(checksum, expectedChecksum) != 1 {
+		return false
 	}
 
 	return true
@@ -159,6 +246,8 @@ func (t *TezosAddress) GetAddressType(address string) (string, error) {
 		return "Secp256k1", nil
 	case "tz3":
 		return "P256", nil
+	case "tz4":
+		return "BLS", nil
 	default:
 		return "", ErrInvalidAddress
 	}
@@ -1,6 +1,7 @@
 package address
 
 import (
+	"crypto/subtle"
 	"encoding/hex"
 	"fmt"
 	"strings"
@@ -22,17 +23,33 @@ func NewTronAddress(testnet bool) *TronAddress {
 	return &TronAddress{testnet: testnet}
 }
 
+func init() {
+	registerChain(ChainTron,
+		func() AddressGenerator { return NewTronAddress(false) },
+		func() AddressGenerator { return NewTronAddress(true) })
+}
+
 // ChainID returns the chain identifier
 func (t *TronAddress) ChainID() ChainID {
 	return ChainTron
 }
 
+// Info describes the chain
+func (t *TronAddress) Info() ChainInfo {
+	return ChainInfo{ChainTron, "TRON", "TRX", "Base58Check", "Starts with 'T'"}
+}
+
 // Generate creates a TRON address from a public key
-// Public key should be 64 bytes (uncompressed without 0x04 prefix)
-// or 65 bytes (uncompressed with 0x04 prefix)
+// Public key can be 33 bytes (compressed, decompressed internally),
+// 64 bytes (uncompressed without 0x04 prefix) or 65 bytes (uncompressed with 0x04 prefix)
 func (t *TronAddress) Generate(publicKey []byte) (string, error) {
 	var key []byte
 
+	publicKey, err := uncompressedKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+
 	switch len(publicKey) {
 	case 64:
 		key = publicKey
@@ -42,7 +59,7 @@ func (t *TronAddress) Generate(publicKey []byte) (string, error) {
 		}
 		key = publicKey[1:]
 	default:
-		return "", ErrInvalidPublicKey
+		return "", keyLengthError(t.ChainID(), len(publicKey), 33, 64, 65)
 	}
 
 	// 1. Keccak-256 hash of the public key
@@ -141,10 +158,8 @@ func (t *TronAddress) Validate(address string) bool {
 	checksum := decoded[21:]
 	expectedChecksum := DoubleSHA256(payload)[:4]
 
-	for i := 0; i < 4; i++ {
-		if checksum[i] != expectedChecksum[i] {
-			return false
-		}
+	if subtle.ConstantTimeCompare(checksum, expectedChecksum) != 1 {
+		return false
 	}
 
 	return true
@@ -217,3 +232,45 @@ func (t *TronAddress) Base58ToHex(base58Addr string) (string, error) {
 	// Return first 21 bytes (without checksum)
 	return hex.EncodeToString(decoded[:21]), nil
 }
+
+// ToEthAddress converts a TRON address, in Base58 or hex form, to the EIP-55
+// checksummed 0x address of the same 20-byte account. TRON and the EVM derive
+// accounts identically and differ only in the network prefix and encoding.
+func (t *TronAddress) ToEthAddress(tronAddr string) (string, error) {
+	if !t.Validate(tronAddr) {
+		return "", ErrInvalidAddress
+	}
+
+	info, err := t.DecodeAddress(tronAddr)
+	if err != nil {
+		return "", err
+	}
+	if info.Version != t.prefix() {
+		return "", fmt.Errorf("%w: TRON prefix 0x%02x", ErrNetworkMismatch, info.Version)
+	}
+
+	return NewEthereumAddress().toChecksumAddress(info.PublicKey), nil
+}
+
+// FromEthAddress converts a 0x EVM address to the Base58 TRON address of the
+// same account on this generator's network
+func (t *TronAddress) FromEthAddress(ethAddr string) (string, error) {
+	info, err := NewEthereumAddress().DecodeAddress(ethAddr)
+	if err != nil {
+		return "", err
+	}
+
+	payload := make([]byte, 21, 25)
+	payload[0] = t.prefix()
+	copy(payload[1:], info.PublicKey)
+
+	return Base58Encode(append(payload, DoubleSHA256(payload)[:4]...)), nil
+}
+
+// prefix returns the address prefix byte of the generator's network
+func (t *TronAddress) prefix() byte {
+	if t.testnet {
+		return TronTestnetPrefix
+	}
+	return TronAddressPrefix
+}
@@ -0,0 +1,109 @@
+package address
+
+import (
+	"crypto/subtle"
+)
+
+// Waves address constants
+const (
+	WavesAddressVersion  byte = 0x01
+	WavesMainnetChainID  byte = 'W' // Addresses start with '3P'
+	WavesTestnetChainID  byte = 'T' // Addresses start with '3M' or '3N'
+	WavesStagenetChainID byte = 'S'
+
+	// version (1) + chain id (1) + public key hash (20) + checksum (4)
+	wavesAddressLength = 26
+)
+
+// WavesAddress generates Waves addresses
+type WavesAddress struct {
+	chainID byte
+}
+
+// NewWavesAddress creates a new Waves address generator for mainnet
+func NewWavesAddress() *WavesAddress {
+	return &WavesAddress{chainID: WavesMainnetChainID}
+}
+
+// NewWavesAddressWithChainID creates a Waves address generator for a specific network byte
+func NewWavesAddressWithChainID(chainID byte) *WavesAddress {
+	return &WavesAddress{chainID: chainID}
+}
+
+func init() {
+	registerChain(ChainWaves,
+		func() AddressGenerator { return NewWavesAddress() },
+		func() AddressGenerator { return NewWavesAddressWithChainID(WavesTestnetChainID) })
+}
+
+// ChainID returns the chain identifier
+func (w *WavesAddress) ChainID() ChainID {
+	return ChainWaves
+}
+
+// Info describes the chain
+func (w *WavesAddress) Info() ChainInfo {
+	return ChainInfo{ChainWaves, "Waves", "WAVES", "Base58", "Starts with '3P'"}
+}
+
+// Generate creates a Waves address from a public key
+// Public key should be 32 bytes (Curve25519/Ed25519)
+func (w *WavesAddress) Generate(publicKey []byte) (string, error) {
+	if len(publicKey) != 32 {
+		return "", keyLengthError(w.ChainID(), len(publicKey), 32)
+	}
+
+	// 1. version || chain id || SecureHash(publicKey)[:20]
+	payload := make([]byte, 0, wavesAddressLength)
+	payload = append(payload, WavesAddressVersion, w.chainID)
+	payload = append(payload, wavesSecureHash(publicKey)[:20]...)
+
+	// 2. Append checksum: SecureHash(payload)[:4]
+	payload = append(payload, wavesSecureHash(payload)[:4]...)
+
+	// 3. Encode with Base58
+	return Base58Encode(payload), nil
+}
+
+// Validate checks if a Waves address is valid
+func (w *WavesAddress) Validate(address string) bool {
+	decoded, err := Base58Decode(address)
+	if err != nil {
+		return false
+	}
+
+	if len(decoded) != wavesAddressLength {
+		return false
+	}
+
+	if decoded[0] != WavesAddressVersion || decoded[1] != w.chainID {
+		return false
+	}
+
+	checksum := decoded[22:]
+	expectedChecksum := wavesSecureHash(decoded[:22])[:4]
+
+	return subtle.ConstantTimeCompare(checksum, expectedChecksum) == 1
+}
+
+// DecodeAddress decodes a Waves address
+func (w *WavesAddress) DecodeAddress(address string) (*AddressInfo, error) {
+	if !w.Validate(address) {
+		return nil, ErrInvalidAddress
+	}
+
+	decoded, _ := Base58Decode(address)
+
+	return &AddressInfo{
+		Address:   address,
+		PublicKey: decoded[2:22], // Public key hash
+		ChainID:   ChainWaves,
+		Type:      AddressTypeBase58,
+		Version:   decoded[0],
+	}, nil
+}
+
+// wavesSecureHash computes Waves' SecureHash: Keccak256(Blake2b256(data))
+func wavesSecureHash(data []byte) []byte {
+	return Keccak256(Blake2b256(data))
+}
@@ -1,6 +1,7 @@
 package address
 
 import (
+	"crypto/subtle"
 	"fmt"
 )
 
@@ -35,16 +36,27 @@ func NewZcashTestnetAddress() *ZcashAddress {
 	return &ZcashAddress{testnet: true}
 }
 
+func init() {
+	registerChain(ChainZcash,
+		func() AddressGenerator { return NewZcashAddress() },
+		func() AddressGenerator { return NewZcashTestnetAddress() })
+}
+
 // ChainID returns the chain identifier
 func (z *ZcashAddress) ChainID() ChainID {
 	return ChainZcash
 }
 
+// Info describes the chain
+func (z *ZcashAddress) Info() ChainInfo {
+	return ChainInfo{ChainZcash, "Zcash", "ZEC", "Base58Check", "Transparent: 't', Shielded: 'z'"}
+}
+
 // Generate creates a Zcash transparent P2PKH address from a public key
 // Public key should be 33 bytes (compressed) or 65 bytes (uncompressed)
 func (z *ZcashAddress) Generate(publicKey []byte) (string, error) {
 	if len(publicKey) != 33 && len(publicKey) != 65 {
-		return "", fmt.Errorf("invalid public key length: expected 33 or 65, got %d", len(publicKey))
+		return "", keyLengthError(z.ChainID(), len(publicKey), 33, 65)
 	}
 
 	return z.P2PKH(publicKey)
@@ -135,10 +147,8 @@ func (z *ZcashAddress) Validate(address string) bool {
 	checksum := decoded[22:]
 	expectedChecksum := DoubleSHA256(payload)[:4]
 
-	for i := 0; i < 4; i++ {
-		if checksum[i] != expectedChecksum[i] {
-			return false
-		}
+	if subtle.ConstantTimeCompare(checksum, expectedChecksum) != 1 {
+		return false
 	}
 
 	// Verify version bytes
@@ -3,6 +3,8 @@ package bip32
 import (
 	"encoding/hex"
 	"testing"
+
+	"github.com/study/crypto-accounts/pkgs/crypto/secp256k1"
 )
 
 // Test vectors from BIP-32 specification
@@ -202,6 +204,26 @@ func TestPublicKeyDerivation(t *testing.T) {
 	}
 }
 
+func TestUncompressedPublicKeyBytes(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	master, _ := NewMasterKey(seed)
+	child, _ := master.DeriveFromPathString("m/0'/1")
+	pub, _ := child.Neuter()
+
+	point, err := secp256k1.DecompressPoint(child.PublicKeyBytes())
+	if err != nil {
+		t.Fatalf("DecompressPoint() error = %v", err)
+	}
+	want := hex.EncodeToString(secp256k1.SerializeUncompressed(point))
+
+	for _, key := range []*ExtendedKey{child, pub.(*ExtendedKey)} {
+		got := key.UncompressedPublicKeyBytes()
+		if len(got) != 65 || hex.EncodeToString(got) != want {
+			t.Errorf("UncompressedPublicKeyBytes(private=%v) = %x, want %s", key.IsPrivate(), got, want)
+		}
+	}
+}
+
 func TestHardenedDerivationFromPublicKey(t *testing.T) {
 	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
 	master, _ := NewMasterKey(seed)
@@ -256,3 +278,50 @@ func TestKeyInterface(t *testing.T) {
 		t.Error("Private key should be 32 bytes")
 	}
 }
+
+func TestDeriveFromRootPath(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	master, _ := NewMasterKey(seed)
+
+	for _, path := range []string{"m", "m/", "M", "M/", ""} {
+		key, err := master.DeriveFromPathString(path)
+		if err != nil {
+			t.Fatalf("DeriveFromPathString(%q) error = %v", path, err)
+		}
+		if key.String() != master.String() {
+			t.Errorf("DeriveFromPathString(%q) = %s, want %s", path, key.String(), master.String())
+		}
+	}
+}
+
+func TestIsChildOf(t *testing.T) {
+	// BIP-32 test vector 1: m and m/0'
+	parent, _ := ParseExtendedKey("xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8")
+	child, _ := ParseExtendedKey("xprv9uHRZZhk6KAJC1avXpDAp4MDc3sQKNxDiPvvkX8Br5ngLNv1TxvUxt4cV1rGL5hj6KCesnDYUhd7oWgT11eZG7XnxHrnYeSvkzY7d2bhkJ7")
+	grandchild, _ := ParseExtendedKey("xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ")
+
+	if !IsChildOf(parent, child) {
+		t.Error("IsChildOf(m, m/0') = false, want true")
+	}
+	if !IsChildOf(child, grandchild) {
+		t.Error("IsChildOf(m/0', m/0'/1) = false, want true")
+	}
+
+	// Reversed, skipped level, and unrelated keys
+	seed, _ := hex.DecodeString("fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542")
+	other, _ := NewMasterKey(seed)
+	tests := []struct {
+		name          string
+		parent, child *ExtendedKey
+	}{
+		{"reversed", child, parent},
+		{"grandchild", parent, grandchild},
+		{"unrelated parent", other, child},
+		{"nil parent", nil, child},
+	}
+	for _, tt := range tests {
+		if IsChildOf(tt.parent, tt.child) {
+			t.Errorf("IsChildOf() = true for %s", tt.name)
+		}
+	}
+}
@@ -96,10 +96,8 @@ func derivePublicChildKey(parentPubKey, IL []byte) ([]byte, error) {
 		return nil, ErrDerivationFailed
 	}
 
-	ilPoint := secp256k1.ScalarBaseMult(IL)
-	childPoint := secp256k1.Add(ilPoint, parentPoint)
-
-	if childPoint.IsInfinity() {
+	childPoint, err := secp256k1.TweakAddPublicKey(parentPoint, IL)
+	if err != nil {
 		return nil, ErrDerivationFailed
 	}
 
@@ -20,4 +20,10 @@ var (
 
 	// ErrInvalidSerializedKey indicates the serialized key data is malformed.
 	ErrInvalidSerializedKey = errors.New("bip32: invalid serialized key")
+
+	// ErrInvalidNetwork indicates a network definition is missing or malformed.
+	ErrInvalidNetwork = errors.New("bip32: invalid network")
+
+	// ErrNetworkConflict indicates a network's version bytes are already registered.
+	ErrNetworkConflict = errors.New("bip32: network version already registered")
 )
@@ -3,6 +3,8 @@
 package bip32
 
 import (
+	"bytes"
+
 	"github.com/study/crypto-accounts/pkgs/crypto/hash"
 	"github.com/study/crypto-accounts/pkgs/crypto/secp256k1"
 )
@@ -116,6 +118,21 @@ func (k *ExtendedKey) PublicKeyBytes() []byte {
 	return secp256k1.PrivateKeyToCompressedPublicKey(k.key[1:])
 }
 
+// UncompressedPublicKeyBytes returns the 65-byte uncompressed public key,
+// as EVM and TRON addresses need. Private keys compute the point directly;
+// public keys decompress the stored key.
+func (k *ExtendedKey) UncompressedPublicKeyBytes() []byte {
+	if k.isPrivate {
+		return secp256k1.SerializeUncompressed(secp256k1.PrivateKeyToPublicKey(k.key[1:]))
+	}
+
+	point, err := secp256k1.DecompressPoint(k.key)
+	if err != nil {
+		return nil
+	}
+	return secp256k1.SerializeUncompressed(point)
+}
+
 // PrivateKeyBytes returns the 32-byte private key, or nil if public.
 func (k *ExtendedKey) PrivateKeyBytes() []byte {
 	if !k.isPrivate {
@@ -149,11 +166,29 @@ func (k *ExtendedKey) Network() *Network {
 	return k.network
 }
 
+// WithNetwork returns a copy of the key that serializes with the version bytes
+// of network. The key material is unchanged.
+func (k *ExtendedKey) WithNetwork(network *Network) *ExtendedKey {
+	clone := *k
+	clone.network = network
+	return &clone
+}
+
 // Fingerprint returns this key's fingerprint (first 4 bytes of Hash160 of public key).
 func (k *ExtendedKey) Fingerprint() []byte {
 	return hash.Hash160(k.PublicKeyBytes())[:4]
 }
 
+// IsChildOf reports whether child is a direct child of parent: its parent
+// fingerprint matches parent's fingerprint and it is one level deeper.
+// Fingerprints are 4 bytes, so a match is strong evidence, not proof.
+func IsChildOf(parent, child *ExtendedKey) bool {
+	if parent == nil || child == nil || child.depth != parent.depth+1 {
+		return false
+	}
+	return bytes.Equal(child.parentFP, parent.Fingerprint())
+}
+
 // Hardened returns a hardened index for the given index.
 func Hardened(index uint32) uint32 {
 	return index + HardenedKeyStart
@@ -1,5 +1,10 @@
 package bip32
 
+import (
+	"fmt"
+	"sync"
+)
+
 // Network represents the version bytes for different cryptocurrency networks.
 // This allows extending to different networks without modifying existing code (OCP).
 type Network struct {
@@ -30,35 +35,96 @@ var (
 		PublicKeyHRP:  "tpub",
 	}
 
+	// LitecoinMainNet is the Litecoin mainnet network configuration.
+	LitecoinMainNet = &Network{
+		Name:          "litecoin",
+		PrivateKeyID:  0x019D9CFE, // Ltpv
+		PublicKeyID:   0x019DA462, // Ltub
+		PrivateKeyHRP: "Ltpv",
+		PublicKeyHRP:  "Ltub",
+	}
+
+	// DogecoinMainNet is the Dogecoin mainnet network configuration.
+	DogecoinMainNet = &Network{
+		Name:          "dogecoin",
+		PrivateKeyID:  0x02FAC398, // dgpv
+		PublicKeyID:   0x02FACAFD, // dgub
+		PrivateKeyHRP: "dgpv",
+		PublicKeyHRP:  "dgub",
+	}
+
 	// DefaultNetwork is the default network used for key generation.
 	DefaultNetwork = MainNet
 )
 
-// NetworkFromVersion returns the Network for a given version byte.
+// registry holds every network whose version bytes can be parsed.
+var registry = struct {
+	sync.RWMutex
+	networks []*Network
+}{
+	networks: []*Network{MainNet, TestNet, LitecoinMainNet, DogecoinMainNet},
+}
+
+// RegisterNetwork adds a network so its extended keys can be parsed.
+// Registering a network whose version bytes are already in use fails.
+func RegisterNetwork(net *Network) error {
+	if net == nil || net.PrivateKeyID == net.PublicKeyID {
+		return ErrInvalidNetwork
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	for _, n := range registry.networks {
+		if n == net {
+			return nil
+		}
+		switch n.PrivateKeyID {
+		case net.PrivateKeyID, net.PublicKeyID:
+			return fmt.Errorf("%w: version %08x used by %s", ErrNetworkConflict, n.PrivateKeyID, n.Name)
+		}
+		switch n.PublicKeyID {
+		case net.PrivateKeyID, net.PublicKeyID:
+			return fmt.Errorf("%w: version %08x used by %s", ErrNetworkConflict, n.PublicKeyID, n.Name)
+		}
+	}
+
+	registry.networks = append(registry.networks, net)
+	return nil
+}
+
+// Networks returns all registered networks.
+func Networks() []*Network {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	return append([]*Network(nil), registry.networks...)
+}
+
+// NetworkFromVersion returns the registered Network for a given version byte.
 func NetworkFromVersion(version uint32) *Network {
-	switch version {
-	case MainNet.PrivateKeyID, MainNet.PublicKeyID:
-		return MainNet
-	case TestNet.PrivateKeyID, TestNet.PublicKeyID:
-		return TestNet
-	default:
-		return nil
+	registry.RLock()
+	defer registry.RUnlock()
+
+	for _, n := range registry.networks {
+		if version == n.PrivateKeyID || version == n.PublicKeyID {
+			return n
+		}
 	}
+	return nil
 }
 
 // IsPrivateVersion returns true if the version indicates a private key.
 func IsPrivateVersion(version uint32) bool {
-	return version == MainNet.PrivateKeyID || version == TestNet.PrivateKeyID
+	net := NetworkFromVersion(version)
+	return net != nil && net.PrivateKeyID == version
 }
 
 // GetPublicVersion returns the public version for a given private version.
 func GetPublicVersion(privateVersion uint32) uint32 {
-	switch privateVersion {
-	case MainNet.PrivateKeyID:
-		return MainNet.PublicKeyID
-	case TestNet.PrivateKeyID:
-		return TestNet.PublicKeyID
-	default:
+	net := NetworkFromVersion(privateVersion)
+	if net == nil || net.PrivateKeyID != privateVersion {
 		return privateVersion
 	}
+	return net.PublicKeyID
 }
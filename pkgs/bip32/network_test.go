@@ -1,6 +1,8 @@
 package bip32
 
 import (
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -269,3 +271,128 @@ func TestNetworkPreservedThroughDerivation(t *testing.T) {
 		t.Errorf("Neutered key prefix = %s, want tpub", pubSerialized[:4])
 	}
 }
+
+func TestParsedTestnetKeyDerivesTestnetChildren(t *testing.T) {
+	const tprv = "tprv8ZgxMBicQKsPeDgjzdC36fs6bMjGApWDNLR9erAXMs5skhMv36j9MV5ecvfavji5khqjWaWSFhN3YcCUUdiKH6isR4Pwy3U5y5egddBr16m"
+
+	master, err := ParseExtendedKey(tprv)
+	if err != nil {
+		t.Fatalf("ParseExtendedKey() error = %v", err)
+	}
+
+	child, err := master.DeriveFromPathString("m/84'/1'/0'/0")
+	if err != nil {
+		t.Fatalf("DeriveFromPathString() error = %v", err)
+	}
+	if child.Network() != TestNet || !strings.HasPrefix(child.String(), "tprv") {
+		t.Errorf("child = %s on %s, want tprv on testnet", child.String(), child.Network().Name)
+	}
+
+	pub, _ := child.Neuter()
+	if !strings.HasPrefix(pub.String(), "tpub") {
+		t.Errorf("Neuter().String() = %s, want tpub prefix", pub.String())
+	}
+}
+
+func TestAltcoinNetworkRoundTrip(t *testing.T) {
+	seed := make([]byte, 32)
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+
+	tests := []struct {
+		network *Network
+		prv     string
+		pub     string
+	}{
+		{LitecoinMainNet, "Ltpv", "Ltub"},
+		{DogecoinMainNet, "dgpv", "dgub"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.network.Name, func(t *testing.T) {
+			key, err := NewMasterKeyWithNetwork(seed, tt.network)
+			if err != nil {
+				t.Fatalf("NewMasterKeyWithNetwork failed: %v", err)
+			}
+
+			encoded := key.String()
+			if !strings.HasPrefix(encoded, tt.prv) {
+				t.Errorf("String() = %s, want prefix %s", encoded, tt.prv)
+			}
+
+			parsed, err := ParseExtendedKey(encoded)
+			if err != nil {
+				t.Fatalf("ParseExtendedKey() error = %v", err)
+			}
+			if parsed.Network() != tt.network || !parsed.IsPrivate() {
+				t.Errorf("parsed network = %v, private = %v", parsed.Network(), parsed.IsPrivate())
+			}
+			if parsed.String() != encoded {
+				t.Errorf("round trip = %s, want %s", parsed.String(), encoded)
+			}
+
+			pub, _ := key.Neuter()
+			pubEncoded := pub.(*ExtendedKey).String()
+			if !strings.HasPrefix(pubEncoded, tt.pub) {
+				t.Errorf("Neuter().String() = %s, want prefix %s", pubEncoded, tt.pub)
+			}
+
+			parsedPub, err := ParseExtendedKey(pubEncoded)
+			if err != nil {
+				t.Fatalf("ParseExtendedKey() error = %v", err)
+			}
+			if parsedPub.Network() != tt.network || parsedPub.IsPrivate() {
+				t.Errorf("parsed public network = %v, private = %v", parsedPub.Network(), parsedPub.IsPrivate())
+			}
+		})
+	}
+}
+
+// unregisterNetwork removes a network registered by a test
+func unregisterNetwork(net *Network) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	for i, n := range registry.networks {
+		if n == net {
+			registry.networks = append(registry.networks[:i], registry.networks[i+1:]...)
+			return
+		}
+	}
+}
+
+func TestRegisterNetwork(t *testing.T) {
+	custom := &Network{
+		Name:          "custom",
+		PrivateKeyID:  0x0A0B0C0D,
+		PublicKeyID:   0x0A0B0C0E,
+		PrivateKeyHRP: "cprv",
+		PublicKeyHRP:  "cpub",
+	}
+	t.Cleanup(func() { unregisterNetwork(custom) })
+
+	if NetworkFromVersion(custom.PrivateKeyID) != nil {
+		t.Fatal("custom network should not be registered yet")
+	}
+
+	if err := RegisterNetwork(custom); err != nil {
+		t.Fatalf("RegisterNetwork() error = %v", err)
+	}
+
+	if NetworkFromVersion(custom.PublicKeyID) != custom {
+		t.Error("NetworkFromVersion() should find the registered network")
+	}
+	if GetPublicVersion(custom.PrivateKeyID) != custom.PublicKeyID {
+		t.Error("GetPublicVersion() should use the registered network")
+	}
+
+	conflict := &Network{Name: "conflict", PrivateKeyID: MainNet.PublicKeyID, PublicKeyID: 0x01020304}
+	if err := RegisterNetwork(conflict); !errors.Is(err, ErrNetworkConflict) {
+		t.Errorf("RegisterNetwork(conflict) error = %v, want ErrNetworkConflict", err)
+	}
+
+	if err := RegisterNetwork(nil); !errors.Is(err, ErrInvalidNetwork) {
+		t.Errorf("RegisterNetwork(nil) error = %v, want ErrInvalidNetwork", err)
+	}
+}
@@ -93,6 +93,7 @@ func (p DerivationPath) String() string {
 }
 
 // DeriveFromPath derives a child key following the given derivation path.
+// An empty path returns the receiver itself.
 func (k *ExtendedKey) DeriveFromPath(path DerivationPath) (*ExtendedKey, error) {
 	current := k
 
@@ -108,6 +109,7 @@ func (k *ExtendedKey) DeriveFromPath(path DerivationPath) (*ExtendedKey, error)
 }
 
 // DeriveFromPathString derives a child key following the given path string.
+// The root paths "m" and "m/" are no-ops that return the receiver.
 func (k *ExtendedKey) DeriveFromPathString(pathStr string) (*ExtendedKey, error) {
 	path, err := ParsePath(pathStr)
 	if err != nil {
@@ -0,0 +1,153 @@
+// Package bip38 implements BIP-38 passphrase-protected private keys: the
+// non-EC-multiply mode that encrypts an existing key into a "6P..." string.
+package bip38
+
+import (
+	"bytes"
+	"crypto/aes"
+	"errors"
+
+	"github.com/study/crypto-accounts/pkgs/address"
+	"github.com/study/crypto-accounts/pkgs/crypto/encoding"
+	"github.com/study/crypto-accounts/pkgs/crypto/hash"
+	"github.com/study/crypto-accounts/pkgs/crypto/kdf"
+	"github.com/study/crypto-accounts/pkgs/crypto/secp256k1"
+)
+
+// scryptKeyLen is the length of the scrypt output BIP-38 splits into two halves
+const scryptKeyLen = 64
+
+// Encrypted key layout: 2 prefix bytes, flag, 4-byte address hash, 32 bytes ciphertext
+const (
+	encryptedLen = 39
+
+	prefixNonEC  = 0x42
+	prefixECMult = 0x43
+
+	flagNonEC      = 0xC0
+	flagCompressed = 0x20
+)
+
+var (
+	// ErrInvalidPrivateKey is returned for keys outside the secp256k1 range
+	ErrInvalidPrivateKey = errors.New("bip38: invalid private key")
+
+	// ErrInvalidEncryptedKey is returned for strings that are not BIP-38 keys
+	ErrInvalidEncryptedKey = errors.New("bip38: invalid encrypted key")
+
+	// ErrECMultiplyUnsupported is returned for EC-multiply ("intermediate code") keys
+	ErrECMultiplyUnsupported = errors.New("bip38: EC-multiply keys are not supported")
+
+	// ErrWrongPassphrase is returned when the decrypted key does not match the address hash
+	ErrWrongPassphrase = errors.New("bip38: wrong passphrase")
+)
+
+// Encrypt encrypts a 32-byte private key with a passphrase. compressed selects
+// which Bitcoin address the key hash commits to and is restored by Decrypt.
+// The passphrase is used as given; BIP-38 expects it in Unicode NFC form.
+func Encrypt(privKey []byte, passphrase string, compressed bool) (string, error) {
+	if len(privKey) != 32 || !secp256k1.IsValidPrivateKey(privKey) {
+		return "", ErrInvalidPrivateKey
+	}
+
+	addrHash, err := addressHash(privKey, compressed)
+	if err != nil {
+		return "", err
+	}
+
+	derived, err := kdf.DeriveKey([]byte(passphrase), addrHash, kdf.BIP38(), scryptKeyLen)
+	if err != nil {
+		return "", err
+	}
+
+	block, err := aes.NewCipher(derived[32:])
+	if err != nil {
+		return "", err
+	}
+
+	flag := byte(flagNonEC)
+	if compressed {
+		flag |= flagCompressed
+	}
+
+	out := make([]byte, encryptedLen)
+	out[0], out[1], out[2] = 0x01, prefixNonEC, flag
+	copy(out[3:7], addrHash)
+
+	half := make([]byte, 16)
+	for i := 0; i < 2; i++ {
+		for j := range half {
+			half[j] = privKey[i*16+j] ^ derived[i*16+j]
+		}
+		block.Encrypt(out[7+i*16:23+i*16], half)
+	}
+
+	return encoding.Base58CheckEncode(out), nil
+}
+
+// Decrypt decrypts a BIP-38 key, returning the private key and whether it
+// belongs to a compressed public key.
+func Decrypt(encrypted, passphrase string) ([]byte, bool, error) {
+	data, err := encoding.Base58CheckDecode(encrypted)
+	if err != nil || len(data) != encryptedLen || data[0] != 0x01 {
+		return nil, false, ErrInvalidEncryptedKey
+	}
+	if data[1] == prefixECMult {
+		return nil, false, ErrECMultiplyUnsupported
+	}
+
+	flag := data[2]
+	if data[1] != prefixNonEC || flag&^flagCompressed != flagNonEC {
+		return nil, false, ErrInvalidEncryptedKey
+	}
+	compressed := flag&flagCompressed != 0
+	addrHash := data[3:7]
+
+	derived, err := kdf.DeriveKey([]byte(passphrase), addrHash, kdf.BIP38(), scryptKeyLen)
+	if err != nil {
+		return nil, false, err
+	}
+
+	block, err := aes.NewCipher(derived[32:])
+	if err != nil {
+		return nil, false, err
+	}
+
+	privKey := make([]byte, 32)
+	for i := 0; i < 2; i++ {
+		half := privKey[i*16 : i*16+16]
+		block.Decrypt(half, data[7+i*16:23+i*16])
+		for j := range half {
+			half[j] ^= derived[i*16+j]
+		}
+	}
+
+	if !secp256k1.IsValidPrivateKey(privKey) {
+		return nil, false, ErrWrongPassphrase
+	}
+	check, err := addressHash(privKey, compressed)
+	if err != nil {
+		return nil, false, err
+	}
+	if !bytes.Equal(check, addrHash) {
+		return nil, false, ErrWrongPassphrase
+	}
+
+	return privKey, compressed, nil
+}
+
+// addressHash returns the first 4 bytes of SHA256(SHA256(address)), where
+// address is the key's mainnet P2PKH address.
+func addressHash(privKey []byte, compressed bool) ([]byte, error) {
+	compressedKey, uncompressedKey, _ := secp256k1.PublicKeyFormats(secp256k1.PrivateKeyToPublicKey(privKey))
+	pubKey := uncompressedKey
+	if compressed {
+		pubKey = compressedKey
+	}
+
+	addr, err := address.NewBitcoinAddress(false).P2PKH(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return hash.DoubleSHA256([]byte(addr))[:4], nil
+}
@@ -0,0 +1,68 @@
+package bip38
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+// BIP-38 reference vectors, no EC multiply
+var vectors = []struct {
+	passphrase string
+	encrypted  string
+	privKey    string
+	compressed bool
+}{
+	{"TestingOneTwoThree", "6PRVWUbkzzsbcVac2qwfssoUJAN1Xhrg6bNk8J7Nzm5H7kxEbn2Nh2ZoGg", "cbf4b9f70470856bb4f40f80b87edb90865997ffee6df315ab166d713af433a5", false},
+	{"Satoshi", "6PRNFFkZc2NZ6dJqFfhRoFNMR9Lnyj7dYGrzdgXXVMXcxoKTePPX1dWByq", "09c2686880095b1a4c249ee3ac4eea8a014f11e6f986d0b5025ac1f39afbd9ae", false},
+	{"TestingOneTwoThree", "6PYNKZ1EAgYgmQfmNVamxyXVWHzK5s6DGhwP4J5o44cvXdoY7sRzhtpUeo", "cbf4b9f70470856bb4f40f80b87edb90865997ffee6df315ab166d713af433a5", true},
+	{"Satoshi", "6PYLtMnXvfG3oJde97zRyLYFZCYizPU5T3LwgdYJz1fRhh16bU7u6PPmY7", "09c2686880095b1a4c249ee3ac4eea8a014f11e6f986d0b5025ac1f39afbd9ae", true},
+}
+
+func TestEncrypt(t *testing.T) {
+	for _, tt := range vectors {
+		privKey, _ := hex.DecodeString(tt.privKey)
+
+		got, err := Encrypt(privKey, tt.passphrase, tt.compressed)
+		if err != nil {
+			t.Fatalf("Encrypt() error = %v", err)
+		}
+		if got != tt.encrypted {
+			t.Errorf("Encrypt(%s, compressed=%v) = %s, want %s", tt.passphrase, tt.compressed, got, tt.encrypted)
+		}
+	}
+}
+
+func TestDecrypt(t *testing.T) {
+	for _, tt := range vectors {
+		privKey, compressed, err := Decrypt(tt.encrypted, tt.passphrase)
+		if err != nil {
+			t.Fatalf("Decrypt(%s) error = %v", tt.encrypted, err)
+		}
+		if hex.EncodeToString(privKey) != tt.privKey {
+			t.Errorf("Decrypt(%s) = %x, want %s", tt.encrypted, privKey, tt.privKey)
+		}
+		if compressed != tt.compressed {
+			t.Errorf("Decrypt(%s) compressed = %v, want %v", tt.encrypted, compressed, tt.compressed)
+		}
+	}
+}
+
+func TestDecryptErrors(t *testing.T) {
+	if _, _, err := Decrypt(vectors[0].encrypted, "wrong"); !errors.Is(err, ErrWrongPassphrase) {
+		t.Errorf("Decrypt() with wrong passphrase error = %v, want ErrWrongPassphrase", err)
+	}
+
+	// EC-multiply vector from BIP-38
+	if _, _, err := Decrypt("6PfQu77ygVyJLZjfvMLyhLMQbYnu5uguoJJ4kMCLqWwPEdfpwANVS76gTX", "TestingOneTwoThree"); !errors.Is(err, ErrECMultiplyUnsupported) {
+		t.Errorf("Decrypt() of an EC-multiply key error = %v, want ErrECMultiplyUnsupported", err)
+	}
+
+	if _, _, err := Decrypt("5KN7MzqK5wt2TP1fQCYyHBtDrXdJuXbUzm4A9rKAteGu3Qi5CVR", "TestingOneTwoThree"); !errors.Is(err, ErrInvalidEncryptedKey) {
+		t.Errorf("Decrypt() of a WIF key error = %v, want ErrInvalidEncryptedKey", err)
+	}
+
+	if _, err := Encrypt(make([]byte, 32), "x", true); !errors.Is(err, ErrInvalidPrivateKey) {
+		t.Errorf("Encrypt() of a zero key error = %v, want ErrInvalidPrivateKey", err)
+	}
+}
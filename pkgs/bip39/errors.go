@@ -20,4 +20,7 @@ var (
 
 	// ErrWordNotFound is returned when a word is not in the word list.
 	ErrWordNotFound = errors.New("word not found in word list")
+
+	// ErrInvalidWordIndex is returned when a word index is outside the 11-bit range 0-2047.
+	ErrInvalidWordIndex = errors.New("word index out of range")
 )
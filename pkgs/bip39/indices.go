@@ -0,0 +1,47 @@
+package bip39
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MnemonicIndices returns the 11-bit word list index of each word of a
+// mnemonic. Only the words are checked: phrases of any length and with a
+// bad checksum are accepted, so partial phrases can be inspected when
+// computing a final checksum word. Use ValidateMnemonic to check a phrase.
+func MnemonicIndices(mnemonic string) ([]int, error) {
+	return MnemonicIndicesWithWordList(mnemonic, DefaultWordList)
+}
+
+// MnemonicIndicesWithWordList is MnemonicIndices for a specific word list.
+func MnemonicIndicesWithWordList(mnemonic string, wordList WordList) ([]int, error) {
+	words := strings.Fields(mnemonic)
+	indices := make([]int, len(words))
+	for i, word := range words {
+		index := wordList.WordIndex(word)
+		if index == -1 {
+			return nil, fmt.Errorf("%w: %q", ErrWordNotFound, word)
+		}
+		indices[i] = index
+	}
+	return indices, nil
+}
+
+// MnemonicFromIndices joins the words at the given word list indices into a
+// mnemonic, the inverse of MnemonicIndices. Like MnemonicIndices it does not
+// check the word count or checksum.
+func MnemonicFromIndices(indices []int) (string, error) {
+	return MnemonicFromIndicesWithWordList(indices, DefaultWordList)
+}
+
+// MnemonicFromIndicesWithWordList is MnemonicFromIndices for a specific word list.
+func MnemonicFromIndicesWithWordList(indices []int, wordList WordList) (string, error) {
+	words := make([]string, len(indices))
+	for i, index := range indices {
+		if index < 0 || index >= wordList.Size() {
+			return "", fmt.Errorf("%w: %d", ErrInvalidWordIndex, index)
+		}
+		words[i] = wordList.WordAt(index)
+	}
+	return strings.Join(words, " "), nil
+}
@@ -0,0 +1,39 @@
+package bip39
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+)
+
+// ErrNoEntropySources is returned when MixEntropy is called without sources.
+var ErrNoEntropySources = errors.New("no entropy sources to mix")
+
+// mixDomain separates MixEntropy output from other uses of SHA-256 over the same input
+const mixDomain = "bip39-mix-entropy"
+
+// MixEntropy combines several entropy sources (dice rolls, system RNG output,
+// user-provided bytes) into entropy of the requested bit length. Each source is
+// length-prefixed and hashed with SHA-256, so the result is unpredictable as
+// long as any one source is, and no source can cancel another out.
+// The output is deterministic for fixed inputs.
+func MixEntropy(bits int, sources ...[]byte) ([]byte, error) {
+	if !isValidEntropyBits(bits) {
+		return nil, ErrInvalidEntropyLength
+	}
+	if len(sources) == 0 {
+		return nil, ErrNoEntropySources
+	}
+
+	h := sha256.New()
+	h.Write([]byte(mixDomain))
+
+	var length [8]byte
+	for _, src := range sources {
+		binary.BigEndian.PutUint64(length[:], uint64(len(src)))
+		h.Write(length[:])
+		h.Write(src)
+	}
+
+	return h.Sum(nil)[:bits/8], nil
+}
@@ -3,9 +3,14 @@ package bip39
 import (
 	"crypto/rand"
 	"crypto/sha256"
+	"io"
 	"strings"
 )
 
+// Rand is the source of randomness for GenerateEntropy. It defaults to
+// crypto/rand; tests and hardware RNG integrations may replace it.
+var Rand io.Reader = rand.Reader
+
 // ValidEntropyBits contains valid entropy sizes in bits.
 var ValidEntropyBits = []int{128, 160, 192, 224, 256}
 
@@ -26,7 +31,7 @@ func GenerateEntropy(bits int) ([]byte, error) {
 	}
 
 	entropy := make([]byte, bits/8)
-	if _, err := rand.Read(entropy); err != nil {
+	if _, err := io.ReadFull(Rand, entropy); err != nil {
 		return nil, err
 	}
 
@@ -145,6 +150,29 @@ func ValidateMnemonicWithWordList(mnemonic string, wordList WordList) bool {
 	return err == nil
 }
 
+// WordCountForEntropy returns the mnemonic word count for an entropy size in bits,
+// or 0 if the size is not valid.
+func WordCountForEntropy(bits int) int {
+	return EntropyToWordCount[bits]
+}
+
+// EntropyBitsForWordCount returns the entropy size in bits encoded by a mnemonic
+// of the given word count.
+func EntropyBitsForWordCount(words int) (int, error) {
+	if !isValidWordCount(words) {
+		return 0, ErrInvalidMnemonicLength
+	}
+
+	// Each word carries 11 bits; one bit in 33 is checksum
+	return words * 11 * 32 / 33, nil
+}
+
+// EntropyBitsForMnemonic returns the entropy size in bits of a mnemonic phrase,
+// checking only its word count.
+func EntropyBitsForMnemonic(mnemonic string) (int, error) {
+	return EntropyBitsForWordCount(len(strings.Fields(mnemonic)))
+}
+
 // isValidEntropyBits checks if entropy bit length is valid.
 func isValidEntropyBits(bits int) bool {
 	for _, valid := range ValidEntropyBits {
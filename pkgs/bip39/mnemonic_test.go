@@ -1,7 +1,11 @@
 package bip39
 
 import (
+	"bytes"
 	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
 	"testing"
 )
 
@@ -204,3 +208,192 @@ func TestMnemonicRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+func TestEntropyBitsForMnemonic(t *testing.T) {
+	tests := []struct {
+		words int
+		bits  int
+	}{
+		{12, 128},
+		{15, 160},
+		{18, 192},
+		{21, 224},
+		{24, 256},
+	}
+
+	for _, tt := range tests {
+		phrase := strings.TrimSpace(strings.Repeat("abandon ", tt.words))
+
+		bits, err := EntropyBitsForMnemonic(phrase)
+		if err != nil {
+			t.Fatalf("EntropyBitsForMnemonic(%d words) error = %v", tt.words, err)
+		}
+		if bits != tt.bits {
+			t.Errorf("EntropyBitsForMnemonic(%d words) = %d, want %d", tt.words, bits, tt.bits)
+		}
+
+		if got := WordCountForEntropy(tt.bits); got != tt.words {
+			t.Errorf("WordCountForEntropy(%d) = %d, want %d", tt.bits, got, tt.words)
+		}
+	}
+
+	phrase := strings.TrimSpace(strings.Repeat("abandon ", 13))
+	if _, err := EntropyBitsForMnemonic(phrase); err != ErrInvalidMnemonicLength {
+		t.Errorf("EntropyBitsForMnemonic(13 words) error = %v, want ErrInvalidMnemonicLength", err)
+	}
+
+	if got := WordCountForEntropy(100); got != 0 {
+		t.Errorf("WordCountForEntropy(100) = %d, want 0", got)
+	}
+}
+
+func TestMixEntropy(t *testing.T) {
+	dice := []byte("3615244162534")
+	system := bytes.Repeat([]byte{0xA5}, 32)
+
+	for _, bits := range ValidEntropyBits {
+		mixed, err := MixEntropy(bits, dice, system)
+		if err != nil {
+			t.Fatalf("MixEntropy(%d) error = %v", bits, err)
+		}
+		if len(mixed)*8 != bits {
+			t.Errorf("MixEntropy(%d) length = %d bits, want %d", bits, len(mixed)*8, bits)
+		}
+
+		again, _ := MixEntropy(bits, dice, system)
+		if !bytes.Equal(mixed, again) {
+			t.Errorf("MixEntropy(%d) is not deterministic: %x != %x", bits, mixed, again)
+		}
+	}
+
+	// Fixed inputs give a fixed result
+	mixed, _ := MixEntropy(128, dice, system)
+	if got := hex.EncodeToString(mixed); got != "c007a0ba6298f3db5c170df336631659" {
+		t.Errorf("MixEntropy(128) = %s, want c007a0ba6298f3db5c170df336631659", got)
+	}
+
+	// Moving bytes between sources changes the result
+	a, _ := MixEntropy(128, []byte("12"), []byte("3"))
+	b, _ := MixEntropy(128, []byte("1"), []byte("23"))
+	if bytes.Equal(a, b) {
+		t.Error("MixEntropy() should depend on source boundaries")
+	}
+
+	if _, err := MixEntropy(100, dice); err != ErrInvalidEntropyLength {
+		t.Errorf("MixEntropy(100) error = %v, want %v", err, ErrInvalidEntropyLength)
+	}
+	if _, err := MixEntropy(128); err != ErrNoEntropySources {
+		t.Errorf("MixEntropy() error = %v, want %v", err, ErrNoEntropySources)
+	}
+}
+
+func TestGenerateEntropyCustomRand(t *testing.T) {
+	defer func(r io.Reader) { Rand = r }(Rand)
+
+	Rand = bytes.NewReader(make([]byte, 16))
+	mnemonic, _, err := GenerateMnemonicAndSeed(128, "")
+	if err != nil {
+		t.Fatalf("GenerateMnemonicAndSeed() error = %v", err)
+	}
+	want := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	if mnemonic != want {
+		t.Errorf("GenerateMnemonicAndSeed() = %q, want %q", mnemonic, want)
+	}
+
+	// An exhausted reader is an error, not short entropy
+	if _, err := GenerateEntropy(128); err == nil {
+		t.Error("GenerateEntropy() should fail once Rand is exhausted")
+	}
+}
+
+func TestSuggestWord(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"abandom", "abandon"},
+		{"zooo", "zoo"},
+		{"Abandon", "abandon"},
+	}
+
+	for _, tt := range tests {
+		got := SuggestWord(tt.word)
+		if len(got) == 0 || got[0] != tt.want {
+			t.Errorf("SuggestWord(%q) = %v, want %s first", tt.word, got, tt.want)
+		}
+	}
+
+	if got := SuggestWord("xxxxxxxxxx"); got != nil {
+		t.Errorf("SuggestWord(xxxxxxxxxx) = %v, want nil", got)
+	}
+}
+
+func TestStrength(t *testing.T) {
+	known := []string{
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo vote",
+		"legal winner thank year wave sausage worth useful legal winner thank yellow",
+		"test test test test test test test test test test test junk",
+		"candy maple cake sugar pudding cream honey rich smooth crumble sweet treat",
+		"myth like bonus scare over problem client lizard pioneer submit female collect",
+	}
+	for _, mnemonic := range known {
+		bits, label, err := Strength(mnemonic)
+		if !errors.Is(err, ErrKnownTestMnemonic) {
+			t.Errorf("Strength(%q) error = %v, want ErrKnownTestMnemonic", mnemonic, err)
+		}
+		if bits == 0 || label == "" {
+			t.Errorf("Strength(%q) = %d, %q, want the phrase's strength", mnemonic, bits, label)
+		}
+	}
+
+	entropy, err := GenerateEntropy(256)
+	if err != nil {
+		t.Fatalf("GenerateEntropy() error = %v", err)
+	}
+	mnemonic, err := NewMnemonic(entropy)
+	if err != nil {
+		t.Fatalf("NewMnemonic() error = %v", err)
+	}
+	bits, label, err := Strength(mnemonic)
+	if err != nil {
+		t.Fatalf("Strength() error = %v", err)
+	}
+	if bits != 256 || label != "strong/256" {
+		t.Errorf("Strength() = %d, %s, want 256, strong/256", bits, label)
+	}
+
+	if _, _, err := Strength("abandon abandon abandon"); !errors.Is(err, ErrInvalidMnemonicLength) {
+		t.Errorf("Strength() of a short phrase error = %v, want ErrInvalidMnemonicLength", err)
+	}
+}
+
+func TestMnemonicIndices(t *testing.T) {
+	mnemonic := "legal winner thank year wave sausage worth useful legal winner thank yellow"
+
+	indices, err := MnemonicIndices(mnemonic)
+	if err != nil {
+		t.Fatalf("MnemonicIndices() error = %v", err)
+	}
+	// "legal" is word 1019 and "yellow" word 2040 of the English list
+	if len(indices) != 12 || indices[0] != 1019 || indices[11] != 2040 {
+		t.Errorf("MnemonicIndices() = %v, want 12 indices starting 1019 and ending 2040", indices)
+	}
+
+	got, err := MnemonicFromIndices(indices)
+	if err != nil {
+		t.Fatalf("MnemonicFromIndices() error = %v", err)
+	}
+	if got != mnemonic {
+		t.Errorf("MnemonicFromIndices(MnemonicIndices()) = %q, want %q", got, mnemonic)
+	}
+
+	if _, err := MnemonicIndices("abandon abandonn"); !errors.Is(err, ErrWordNotFound) {
+		t.Errorf("MnemonicIndices() with unknown word error = %v, want ErrWordNotFound", err)
+	}
+	for _, index := range []int{-1, 2048} {
+		if _, err := MnemonicFromIndices([]int{0, index}); !errors.Is(err, ErrInvalidWordIndex) {
+			t.Errorf("MnemonicFromIndices(%d) error = %v, want ErrInvalidWordIndex", index, err)
+		}
+	}
+}
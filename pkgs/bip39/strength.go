@@ -0,0 +1,58 @@
+package bip39
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrKnownTestMnemonic is returned by Strength, alongside the strength of the
+// phrase, when a mnemonic is a published test phrase whose funds anyone can take.
+var ErrKnownTestMnemonic = errors.New("mnemonic is a well-known test phrase")
+
+// strengthLabels names the security level of each entropy size
+var strengthLabels = map[int]string{
+	128: "weak/128",
+	160: "weak/160",
+	192: "medium/192",
+	224: "strong/224",
+	256: "strong/256",
+}
+
+// knownTestMnemonics are published phrases not caught by the repeated-byte
+// check, such as the default accounts of Ethereum development tools.
+var knownTestMnemonics = map[string]bool{
+	"test test test test test test test test test test test junk":                    true,
+	"candy maple cake sugar pudding cream honey rich smooth crumble sweet treat":     true,
+	"myth like bonus scare over problem client lizard pioneer submit female collect": true,
+}
+
+// Strength returns the entropy size of a valid English mnemonic and a label
+// such as "weak/128" or "strong/256". If the phrase is a well-known test
+// mnemonic, the bits and label are still returned along with
+// ErrKnownTestMnemonic so wallets can warn before importing it.
+func Strength(mnemonic string) (bits int, securityLevel string, err error) {
+	entropy, err := MnemonicToEntropy(mnemonic)
+	if err != nil {
+		return 0, "", err
+	}
+
+	bits = len(entropy) * 8
+	securityLevel = strengthLabels[bits]
+	if isKnownTestEntropy(entropy) || knownTestMnemonics[strings.Join(strings.Fields(mnemonic), " ")] {
+		return bits, securityLevel, ErrKnownTestMnemonic
+	}
+
+	return bits, securityLevel, nil
+}
+
+// isKnownTestEntropy reports whether entropy repeats a single byte, as the
+// BIP-39 test vectors "abandon ... about" (0x00), "legal winner ..." (0x7f),
+// "letter advice ..." (0x80) and "zoo ... wrong" (0xff) do.
+func isKnownTestEntropy(entropy []byte) bool {
+	for _, b := range entropy[1:] {
+		if b != entropy[0] {
+			return false
+		}
+	}
+	return true
+}
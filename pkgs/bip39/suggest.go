@@ -0,0 +1,60 @@
+package bip39
+
+import "strings"
+
+// maxSuggestDistance is the largest edit distance SuggestWord still treats as a typo
+const maxSuggestDistance = 2
+
+// SuggestWord returns the English word list entries closest to word by
+// Levenshtein distance, for "did you mean" hints on mistyped mnemonic words.
+// All entries at the smallest distance are returned in word list order; nil
+// is returned if none is within two edits.
+func SuggestWord(word string) []string {
+	return SuggestWordWithWordList(word, DefaultWordList)
+}
+
+// SuggestWordWithWordList is SuggestWord for a specific word list.
+func SuggestWordWithWordList(word string, wordList WordList) []string {
+	word = strings.ToLower(word)
+
+	var suggestions []string
+	best := maxSuggestDistance + 1
+	for _, candidate := range wordList.Words() {
+		d := editDistance(word, candidate)
+		switch {
+		case d < best:
+			best = d
+			suggestions = []string{candidate}
+		case d == best:
+			suggestions = append(suggestions, candidate)
+		}
+	}
+
+	return suggestions
+}
+
+// editDistance returns the Levenshtein distance between a and b, counting
+// insertions, deletions and substitutions of single characters.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
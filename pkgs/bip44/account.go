@@ -44,6 +44,22 @@ func (a *Account) PublicKey() (*bip32.ExtendedKey, error) {
 	return pub.(*bip32.ExtendedKey), nil
 }
 
+// ChangeXPub returns the serialized extended public key of a change branch
+// (account / change). It lets the holder derive the addresses of that branch
+// only, e.g. receiving addresses without seeing change addresses.
+func (a *Account) ChangeXPub(change uint32) (string, error) {
+	changeKey, err := a.accountKey.Child(change)
+	if err != nil {
+		return "", err
+	}
+
+	pub, err := changeKey.Neuter()
+	if err != nil {
+		return "", err
+	}
+	return pub.String(), nil
+}
+
 // DeriveAddress derives an address key at the specified change and index.
 func (a *Account) DeriveAddress(change, index uint32) (*bip32.ExtendedKey, error) {
 	// Derive change level: account / change
@@ -121,8 +137,13 @@ func (a *Account) GetAddressInfo(change, index uint32) (*AddressInfo, error) {
 		return nil, err
 	}
 
+	return newAddressInfo(a.Path(change, index), key), nil
+}
+
+// newAddressInfo collects the key material of a derived address.
+func newAddressInfo(path *Path, key *bip32.ExtendedKey) *AddressInfo {
 	info := &AddressInfo{
-		Path:      a.Path(change, index),
+		Path:      path,
 		PublicKey: key.PublicKeyBytes(),
 		ChainCode: key.ChainCode(),
 	}
@@ -131,5 +152,5 @@ func (a *Account) GetAddressInfo(change, index uint32) (*AddressInfo, error) {
 		info.PrivateKey = key.PrivateKeyBytes()
 	}
 
-	return info, nil
+	return info
 }
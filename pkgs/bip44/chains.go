@@ -0,0 +1,88 @@
+package bip44
+
+import (
+	"fmt"
+
+	"github.com/study/crypto-accounts/pkgs/address"
+	"github.com/study/crypto-accounts/pkgs/crypto/ed25519"
+)
+
+// CoinTypeForChain returns the coin type wallets derive a chain's keys under.
+func CoinTypeForChain(chainID address.ChainID) (CoinType, bool) {
+	coinType, ok := address.ChainCoinTypes[chainID]
+	return CoinType(coinType), ok
+}
+
+// ChainAddress is one address a wallet controls on a chain.
+type ChainAddress struct {
+	ChainID   address.ChainID
+	Curve     address.Curve
+	Path      string
+	Address   string
+	PublicKey []byte
+}
+
+// ChainAddresses derives the first count receiving addresses of an account on
+// a chain, choosing the curve and path reference wallets use: BIP-44 for
+// secp256k1 chains and SLIP-10 for Ed25519 chains. Ed25519 schemes without an
+// index level, like Solana's, yield count consecutive accounts from account.
+func (w *Wallet) ChainAddresses(chainID address.ChainID, account, count uint32) ([]ChainAddress, error) {
+	curve, ok := address.ChainCurves[chainID]
+	if !ok {
+		return nil, fmt.Errorf("%w: no derivation for %s", address.ErrUnsupportedChain, chainID)
+	}
+
+	addresses := make([]ChainAddress, 0, count)
+
+	if curve == address.CurveEd25519 {
+		scheme, err := address.Ed25519DerivationScheme(chainID)
+		if err != nil {
+			return nil, err
+		}
+
+		if scheme.EnumeratesAccounts() && uint64(account)+uint64(count) > uint64(ed25519.HardenedOffset) {
+			return nil, fmt.Errorf("%w: accounts %d+%d exceed the hardened range", address.ErrInvalidIndex, account, count)
+		}
+
+		for i := uint32(0); i < count; i++ {
+			acc, index := account, i
+			if scheme.EnumeratesAccounts() {
+				acc, index = account+i, 0
+			}
+
+			_, pubKey, err := scheme.DeriveKey(w.seed, acc, index)
+			if err != nil {
+				return nil, err
+			}
+			addr, err := address.Generate(chainID, pubKey)
+			if err != nil {
+				return nil, err
+			}
+			path, err := scheme.PathString(acc, index)
+			if err != nil {
+				return nil, err
+			}
+			addresses = append(addresses, ChainAddress{chainID, curve, path, addr, pubKey})
+		}
+		return addresses, nil
+	}
+
+	coinType, ok := CoinTypeForChain(chainID)
+	if !ok {
+		return nil, fmt.Errorf("%w: no coin type for %s", address.ErrUnsupportedChain, chainID)
+	}
+
+	infos, err := w.DeriveAddresses(coinType, account, ExternalChain, 0, count)
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range infos {
+		addr, err := address.Generate(chainID, info.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, ChainAddress{chainID, curve, info.Path.String(), addr, info.PublicKey})
+	}
+
+	return addresses, nil
+}
@@ -1,6 +1,10 @@
 // Package bip44 implements BIP-44 multi-account hierarchy for deterministic wallets.
 package bip44
 
+import (
+	"github.com/study/crypto-accounts/pkgs/bip32"
+)
+
 // CoinType represents a cryptocurrency coin type as defined in SLIP-44.
 // https://github.com/satoshilabs/slips/blob/master/slip-0044.md
 type CoinType uint32
@@ -21,6 +25,7 @@ const (
 	CoinTypeBinance         CoinType = 714
 	CoinTypeSolana          CoinType = 501
 	CoinTypePolygon         CoinType = 966
+	CoinTypeQtum            CoinType = 2301
 	CoinTypeAvalanche       CoinType = 9000
 )
 
@@ -124,6 +129,27 @@ var coinRegistry = map[CoinType]CoinInfo{
 		Name:     "Avalanche",
 		Decimals: 18,
 	},
+	CoinTypeQtum: {
+		Type:     CoinTypeQtum,
+		Symbol:   "QTUM",
+		Name:     "Qtum",
+		Decimals: 8,
+	},
+}
+
+// coinNetworks maps coin types whose wallets use their own extended key
+// version bytes (e.g. Ltpv/Ltub for Litecoin) to that network.
+var coinNetworks = map[CoinType]*bip32.Network{
+	CoinTypeBitcoin:  bip32.MainNet,
+	CoinTypeTestnet:  bip32.TestNet,
+	CoinTypeLitecoin: bip32.LitecoinMainNet,
+	CoinTypeDogecoin: bip32.DogecoinMainNet,
+}
+
+// NetworkForCoin returns the extended key network for a coin type, or nil if
+// the coin has no network of its own and keeps the wallet's default.
+func NetworkForCoin(coinType CoinType) *bip32.Network {
+	return coinNetworks[coinType]
 }
 
 // GetCoinInfo returns the coin information for a given coin type.
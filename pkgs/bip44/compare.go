@@ -0,0 +1,45 @@
+package bip44
+
+import (
+	"bytes"
+	"errors"
+)
+
+// ErrNilWallet is returned when a wallet argument is nil
+var ErrNilWallet = errors.New("wallet is nil")
+
+// CompareWallets derives the first n external addresses of account 0 for
+// coinType from both wallets and returns the indices whose public keys differ.
+// An empty result means the wallets agree, e.g. a restored seed matches a reference.
+func CompareWallets(a, b *Wallet, coinType CoinType, n uint32) ([]int, error) {
+	if a == nil || b == nil {
+		return nil, ErrNilWallet
+	}
+
+	accA, err := a.DeriveAccount(coinType, 0)
+	if err != nil {
+		return nil, err
+	}
+	accB, err := b.DeriveAccount(coinType, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []int
+	for i := uint32(0); i < n; i++ {
+		keyA, err := accA.DeriveExternalAddress(i)
+		if err != nil {
+			return nil, err
+		}
+		keyB, err := accB.DeriveExternalAddress(i)
+		if err != nil {
+			return nil, err
+		}
+
+		if !bytes.Equal(keyA.PublicKeyBytes(), keyB.PublicKeyBytes()) {
+			diffs = append(diffs, int(i))
+		}
+	}
+
+	return diffs, nil
+}
@@ -0,0 +1,78 @@
+package bip44
+
+import (
+	"errors"
+
+	"github.com/study/crypto-accounts/pkgs/bip32"
+)
+
+// ErrIteratorExhausted is returned once every non-hardened address index has been used.
+var ErrIteratorExhausted = errors.New("address iterator exhausted")
+
+// AddressIterator walks consecutive addresses on one change chain.
+// The change-level key is derived once, so each step costs a single child derivation.
+type AddressIterator struct {
+	account   *Account
+	change    uint32
+	changeKey *bip32.ExtendedKey
+	next      uint32
+}
+
+// AddressIterator returns an iterator over m/44'/coinType'/account'/change/i starting at i = 0.
+func (w *Wallet) AddressIterator(coinType CoinType, account, change uint32) (*AddressIterator, error) {
+	acc, err := w.DeriveAccount(coinType, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return acc.AddressIterator(change)
+}
+
+// AddressIterator returns an iterator over this account's addresses on a change chain.
+func (a *Account) AddressIterator(change uint32) (*AddressIterator, error) {
+	changeKey, err := a.accountKey.Child(change)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AddressIterator{
+		account:   a,
+		change:    change,
+		changeKey: changeKey.(*bip32.ExtendedKey),
+	}, nil
+}
+
+// Next derives the next address. Indices that yield an invalid key are skipped, as BIP-32 requires.
+func (it *AddressIterator) Next() (*AddressInfo, error) {
+	for it.next < bip32.HardenedKeyStart {
+		index := it.next
+		it.next++
+
+		child, err := it.changeKey.Child(index)
+		if errors.Is(err, bip32.ErrDerivationFailed) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		key := child.(*bip32.ExtendedKey)
+
+		info := &AddressInfo{
+			Path:      it.account.Path(it.change, index),
+			PublicKey: key.PublicKeyBytes(),
+			ChainCode: key.ChainCode(),
+		}
+		if key.IsPrivate() {
+			info.PrivateKey = key.PrivateKeyBytes()
+		}
+
+		return info, nil
+	}
+
+	return nil, ErrIteratorExhausted
+}
+
+// Seek positions the iterator so the next call to Next returns index.
+func (it *AddressIterator) Seek(index uint32) {
+	it.next = index
+}
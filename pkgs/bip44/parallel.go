@@ -0,0 +1,66 @@
+package bip44
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/study/crypto-accounts/pkgs/bip32"
+)
+
+// DeriveAddressesParallel derives the same addresses as DeriveAddresses,
+// splitting the index range into contiguous chunks across workers. The change
+// key is derived once and shared; results are returned in index order.
+// A workers value of zero or less uses GOMAXPROCS.
+func (w *Wallet) DeriveAddressesParallel(coinType CoinType, account, change, start, count uint32, workers int) ([]*AddressInfo, error) {
+	acc, err := w.DeriveAccount(coinType, account)
+	if err != nil {
+		return nil, err
+	}
+	changeKey, err := acc.Key().Child(change)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]*AddressInfo, count)
+	if count == 0 {
+		return addresses, nil
+	}
+
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if uint32(workers) > count {
+		workers = int(count)
+	}
+
+	errs := make([]error, workers)
+	chunk := (count + uint32(workers) - 1) / uint32(workers)
+
+	var wg sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		lo := uint32(worker) * chunk
+		hi := min(lo+chunk, count)
+
+		wg.Add(1)
+		go func(worker int, lo, hi uint32) {
+			defer wg.Done()
+			for i := lo; i < hi; i++ {
+				key, err := changeKey.Child(start + i)
+				if err != nil {
+					errs[worker] = err
+					return
+				}
+				addresses[i] = newAddressInfo(acc.Path(change, start+i), key.(*bip32.ExtendedKey))
+			}
+		}(worker, lo, hi)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return addresses, nil
+}
@@ -32,12 +32,18 @@ var (
 )
 
 // Path represents a BIP-44 derivation path.
+//
+// BIP-44 leaves Change and AddressIndex non-hardened, but some wallets harden
+// them, e.g. Ledger Live and Phantom derive Solana keys at m/44'/501'/0'/0'
+// and beyond. HardenedChange and HardenedIndex reproduce such paths.
 type Path struct {
-	Purpose      uint32
-	CoinType     CoinType
-	Account      uint32
-	Change       uint32
-	AddressIndex uint32
+	Purpose        uint32
+	CoinType       CoinType
+	Account        uint32
+	Change         uint32
+	AddressIndex   uint32
+	HardenedChange bool
+	HardenedIndex  bool
 }
 
 // NewPath creates a new BIP-44 path with default values.
@@ -70,14 +76,14 @@ func EthereumPath(account, change, addressIndex uint32) *Path {
 }
 
 // String returns the string representation of the path.
-// Example: m/44'/0'/0'/0/0
+// Example: m/44'/0'/0'/0/0, or m/44'/501'/0'/0'/0' with hardened change and index
 func (p *Path) String() string {
-	return fmt.Sprintf("m/%d'/%d'/%d'/%d/%d",
+	return fmt.Sprintf("m/%d'/%d'/%d'/%s/%s",
 		p.Purpose,
 		p.CoinType,
 		p.Account,
-		p.Change,
-		p.AddressIndex,
+		formatIndex(p.Change, p.HardenedChange),
+		formatIndex(p.AddressIndex, p.HardenedIndex),
 	)
 }
 
@@ -87,11 +93,27 @@ func (p *Path) ToBIP32Path() bip32.DerivationPath {
 		bip32.Hardened(p.Purpose),
 		bip32.Hardened(uint32(p.CoinType)),
 		bip32.Hardened(p.Account),
-		p.Change,
-		p.AddressIndex,
+		maybeHardened(p.Change, p.HardenedChange),
+		maybeHardened(p.AddressIndex, p.HardenedIndex),
 	}
 }
 
+// formatIndex formats a path index, appending ' if it is hardened.
+func formatIndex(index uint32, hardened bool) string {
+	if hardened {
+		return fmt.Sprintf("%d'", index)
+	}
+	return strconv.FormatUint(uint64(index), 10)
+}
+
+// maybeHardened returns the BIP-32 child index for a path index.
+func maybeHardened(index uint32, hardened bool) uint32 {
+	if hardened {
+		return bip32.Hardened(index)
+	}
+	return index
+}
+
 // AccountPath returns the account-level path (m/44'/coin'/account').
 func (p *Path) AccountPath() string {
 	return fmt.Sprintf("m/%d'/%d'/%d'", p.Purpose, p.CoinType, p.Account)
@@ -105,6 +127,9 @@ func (p *Path) WithAccount(account uint32) *Path {
 		Account:      account,
 		Change:       p.Change,
 		AddressIndex: p.AddressIndex,
+
+		HardenedChange: p.HardenedChange,
+		HardenedIndex:  p.HardenedIndex,
 	}
 }
 
@@ -116,6 +141,9 @@ func (p *Path) WithChange(change uint32) *Path {
 		Account:      p.Account,
 		Change:       change,
 		AddressIndex: p.AddressIndex,
+
+		HardenedChange: p.HardenedChange,
+		HardenedIndex:  p.HardenedIndex,
 	}
 }
 
@@ -127,6 +155,9 @@ func (p *Path) WithAddressIndex(index uint32) *Path {
 		Account:      p.Account,
 		Change:       p.Change,
 		AddressIndex: index,
+
+		HardenedChange: p.HardenedChange,
+		HardenedIndex:  p.HardenedIndex,
 	}
 }
 
@@ -136,7 +167,8 @@ func (p *Path) Next() *Path {
 }
 
 // ParsePath parses a BIP-44 path string.
-// Expected format: m/44'/coinType'/account'/change/addressIndex
+// Expected format: m/44'/coinType'/account'/change/addressIndex, where change
+// and addressIndex may be hardened as some wallets do.
 func ParsePath(path string) (*Path, error) {
 	path = strings.TrimSpace(path)
 	if !strings.HasPrefix(path, "m/") {
@@ -166,8 +198,8 @@ func ParsePath(path string) (*Path, error) {
 		return nil, fmt.Errorf("invalid account: %w", err)
 	}
 
-	// Parse change (not hardened)
-	change, err := parseIndex(parts[3])
+	// Parse change (normally not hardened)
+	change, hardenedChange, err := parseAnyIndex(parts[3])
 	if err != nil {
 		return nil, fmt.Errorf("invalid change: %w", err)
 	}
@@ -175,8 +207,8 @@ func ParsePath(path string) (*Path, error) {
 		return nil, ErrInvalidChange
 	}
 
-	// Parse address index (not hardened)
-	addressIndex, err := parseIndex(parts[4])
+	// Parse address index (normally not hardened)
+	addressIndex, hardenedIndex, err := parseAnyIndex(parts[4])
 	if err != nil {
 		return nil, fmt.Errorf("invalid address index: %w", err)
 	}
@@ -187,6 +219,9 @@ func ParsePath(path string) (*Path, error) {
 		Account:      account,
 		Change:       change,
 		AddressIndex: addressIndex,
+
+		HardenedChange: hardenedChange,
+		HardenedIndex:  hardenedIndex,
 	}, nil
 }
 
@@ -217,3 +252,14 @@ func parseIndex(s string) (uint32, error) {
 	}
 	return uint32(val), nil
 }
+
+// parseAnyIndex parses an index that may be hardened or not.
+func parseAnyIndex(s string) (uint32, bool, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasSuffix(s, "'") || strings.HasSuffix(s, "h") {
+		val, err := parseHardenedIndex(s)
+		return val, true, err
+	}
+	val, err := parseIndex(s)
+	return val, false, err
+}
@@ -1,7 +1,12 @@
 package bip44
 
 import (
+	"bytes"
+	"reflect"
 	"testing"
+
+	"github.com/study/crypto-accounts/pkgs/bip32"
+	"github.com/study/crypto-accounts/pkgs/crypto/ed25519"
 )
 
 func TestNewPath(t *testing.T) {
@@ -244,3 +249,45 @@ func TestToBIP32Path(t *testing.T) {
 		}
 	}
 }
+
+func TestHardenedChangePath(t *testing.T) {
+	path := NewPath(CoinTypeSolana, 0, 0, 0)
+	path.HardenedChange = true
+	path.HardenedIndex = true
+
+	if got := path.String(); got != "m/44'/501'/0'/0'/0'" {
+		t.Errorf("String() = %s, want m/44'/501'/0'/0'/0'", got)
+	}
+	if got, want := path.ToBIP32Path(), ed25519.HardenedPath(44, 501, 0, 0, 0); !reflect.DeepEqual([]uint32(got), want) {
+		t.Errorf("ToBIP32Path() = %v, want %v", got, want)
+	}
+	if next := path.Next(); next.String() != "m/44'/501'/0'/0'/1'" {
+		t.Errorf("Next() = %s, want m/44'/501'/0'/0'/1'", next.String())
+	}
+
+	parsed, err := ParsePath("m/44'/501'/0'/0'/0'")
+	if err != nil {
+		t.Fatalf("ParsePath() error = %v", err)
+	}
+	if !reflect.DeepEqual(parsed, path) {
+		t.Errorf("ParsePath() = %+v, want %+v", parsed, path)
+	}
+
+	// Hardened change only, derived through the wallet
+	wallet, _ := NewWalletFromMnemonic(testMnemonic, "")
+	btcPath := NewPath(CoinTypeBitcoin, 0, 0, 3)
+	btcPath.HardenedChange = true
+	if btcPath.String() != "m/44'/0'/0'/0'/3" {
+		t.Errorf("String() = %s, want m/44'/0'/0'/0'/3", btcPath.String())
+	}
+	key, err := wallet.DeriveKey(btcPath)
+	if err != nil {
+		t.Fatalf("DeriveKey() error = %v", err)
+	}
+	want, _ := wallet.MasterKey().DeriveFromPath(bip32.DerivationPath{
+		bip32.Hardened(44), bip32.Hardened(0), bip32.Hardened(0), bip32.Hardened(0), 3,
+	})
+	if !bytes.Equal(key.PublicKeyBytes(), want.PublicKeyBytes()) {
+		t.Errorf("DeriveKey(%s) = %x, want %x", btcPath, key.PublicKeyBytes(), want.PublicKeyBytes())
+	}
+}
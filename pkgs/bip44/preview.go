@@ -0,0 +1,50 @@
+package bip44
+
+import (
+	"github.com/study/crypto-accounts/pkgs/address"
+)
+
+// PreviewRanges selects the address indices a preview covers on each chain.
+type PreviewRanges struct {
+	Start    uint32 // First address index on both chains
+	External uint32 // Number of receiving addresses
+	Internal uint32 // Number of change addresses
+}
+
+// PreviewEntry is one derived address in a preview.
+type PreviewEntry struct {
+	Path      *Path
+	Address   string
+	PublicKey []byte // Compressed public key
+}
+
+// Preview derives the receiving addresses followed by the change addresses of
+// an account, so recovery tools can list every candidate address in one call.
+func (w *Wallet) Preview(chainID address.ChainID, coinType CoinType, account uint32, ranges PreviewRanges) ([]PreviewEntry, error) {
+	entries := make([]PreviewEntry, 0, ranges.External+ranges.Internal)
+
+	for _, chain := range []struct{ change, count uint32 }{
+		{ExternalChain, ranges.External},
+		{InternalChain, ranges.Internal},
+	} {
+		infos, err := w.DeriveAddresses(coinType, account, chain.change, ranges.Start, chain.count)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, info := range infos {
+			addr, err := address.Generate(chainID, info.PublicKey)
+			if err != nil {
+				return nil, err
+			}
+
+			entries = append(entries, PreviewEntry{
+				Path:      info.Path,
+				Address:   addr,
+				PublicKey: info.PublicKey,
+			})
+		}
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,62 @@
+package bip44
+
+import (
+	"github.com/study/crypto-accounts/pkgs/bip32"
+)
+
+// DerivationStep records the key produced at one level of a derivation.
+type DerivationStep struct {
+	Path        string // Path up to and including this level, e.g. "m/44'/60'"
+	Index       uint32 // Child index, including the hardened offset
+	ExtendedKey string // Serialized extended private key at this level
+	PublicKey   []byte // Compressed public key at this level
+	Fingerprint []byte // Key fingerprint (HASH160 of the public key, first 4 bytes)
+}
+
+// DerivationTrace holds every intermediate value of a seed-to-key derivation,
+// so the origin of an address can be audited step by step.
+type DerivationTrace struct {
+	Seed       []byte
+	MasterKey  string // Serialized master extended private key
+	Path       *Path
+	Steps      []DerivationStep
+	PrivateKey []byte // Private key at the full path
+	PublicKey  []byte // Compressed public key at the full path
+}
+
+// TraceDerivation derives the key at path from seed, recording each level.
+func TraceDerivation(seed []byte, path *Path) (*DerivationTrace, error) {
+	master, err := bip32.NewMasterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	trace := &DerivationTrace{
+		Seed:      seed,
+		MasterKey: master.String(),
+		Path:      path,
+	}
+
+	indices := path.ToBIP32Path()
+	current := master
+	for i, idx := range indices {
+		child, err := current.Child(idx)
+		if err != nil {
+			return nil, err
+		}
+		current = child.(*bip32.ExtendedKey)
+
+		trace.Steps = append(trace.Steps, DerivationStep{
+			Path:        indices[:i+1].String(),
+			Index:       idx,
+			ExtendedKey: current.String(),
+			PublicKey:   current.PublicKeyBytes(),
+			Fingerprint: current.Fingerprint(),
+		})
+	}
+
+	trace.PrivateKey = current.PrivateKeyBytes()
+	trace.PublicKey = current.PublicKeyBytes()
+
+	return trace, nil
+}
@@ -1,6 +1,7 @@
 package bip44
 
 import (
+	"github.com/study/crypto-accounts/pkgs/address"
 	"github.com/study/crypto-accounts/pkgs/bip32"
 	"github.com/study/crypto-accounts/pkgs/bip39"
 )
@@ -8,6 +9,7 @@ import (
 // Wallet represents a BIP-44 HD wallet.
 type Wallet struct {
 	masterKey *bip32.ExtendedKey
+	seed      []byte // Kept for SLIP-10 Ed25519 derivation
 	mnemonic  string
 }
 
@@ -20,6 +22,7 @@ func NewWalletFromSeed(seed []byte) (*Wallet, error) {
 
 	return &Wallet{
 		masterKey: master,
+		seed:      append([]byte(nil), seed...),
 	}, nil
 }
 
@@ -67,12 +70,16 @@ func (w *Wallet) Mnemonic() string {
 
 // DeriveAccount derives a BIP-44 account for a coin type.
 // Path: m/44'/coinType'/account'
+// The account key serializes with the coin's version bytes (see NetworkForCoin).
 func (w *Wallet) DeriveAccount(coinType CoinType, accountIndex uint32) (*Account, error) {
 	path := NewPath(coinType, accountIndex, 0, 0).AccountPath()
 	accountKey, err := w.masterKey.DeriveFromPathString(path)
 	if err != nil {
 		return nil, err
 	}
+	if network := NetworkForCoin(coinType); network != nil {
+		accountKey = accountKey.WithNetwork(network)
+	}
 
 	return NewAccount(coinType, accountIndex, accountKey), nil
 }
@@ -141,3 +148,38 @@ func (w *Wallet) DeriveAddresses(coinType CoinType, account, change, startIndex,
 
 	return addresses, nil
 }
+
+// KeyOrigin returns the key origin data a PSBT needs for an input or output:
+// the master key fingerprint, the full derivation path and the compressed
+// public key at that path.
+func (w *Wallet) KeyOrigin(path *Path) (masterFingerprint [4]byte, derivation []uint32, pubKey []byte, err error) {
+	derivation = path.ToBIP32Path()
+	key, err := w.masterKey.DeriveFromPath(derivation)
+	if err != nil {
+		return masterFingerprint, nil, nil, err
+	}
+
+	copy(masterFingerprint[:], w.masterKey.Fingerprint())
+	return masterFingerprint, derivation, key.PublicKeyBytes(), nil
+}
+
+// VerifyAddress derives the key at path, generates its address for chainID and
+// reports whether it matches expectedAddress. Wallets use this to confirm a
+// receive address was not altered in transit.
+func (w *Wallet) VerifyAddress(chainID address.ChainID, path *Path, expectedAddress string) (bool, error) {
+	key, err := w.DeriveKey(path)
+	if err != nil {
+		return false, err
+	}
+
+	addr, err := address.Generate(chainID, key.PublicKeyBytes())
+	if err != nil {
+		// Some generators only take the uncompressed key
+		addr, err = address.Generate(chainID, key.UncompressedPublicKeyBytes())
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return addr == expectedAddress, nil
+}
@@ -1,10 +1,17 @@
 package bip44
 
 import (
+	"bytes"
 	"encoding/hex"
+	"errors"
+	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/study/crypto-accounts/pkgs/address"
+	"github.com/study/crypto-accounts/pkgs/bip32"
 	"github.com/study/crypto-accounts/pkgs/bip39"
+	"github.com/study/crypto-accounts/pkgs/crypto/ed25519"
 )
 
 const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
@@ -164,6 +171,48 @@ func TestEthereumAccount(t *testing.T) {
 	}
 }
 
+func TestDeriveAccountNetwork(t *testing.T) {
+	wallet, _ := NewWalletFromMnemonic(testMnemonic, "")
+
+	tests := []struct {
+		coinType CoinType
+		prv, pub string
+	}{
+		{CoinTypeBitcoin, "xprv", "xpub"},
+		{CoinTypeLitecoin, "Ltpv", "Ltub"},
+		{CoinTypeDogecoin, "dgpv", "dgub"},
+		{CoinTypeEthereum, "xprv", "xpub"},
+	}
+
+	for _, tt := range tests {
+		account, err := wallet.DeriveAccount(tt.coinType, 0)
+		if err != nil {
+			t.Fatalf("DeriveAccount(%d) error = %v", tt.coinType, err)
+		}
+
+		prv := account.Key().String()
+		if !strings.HasPrefix(prv, tt.prv) {
+			t.Errorf("DeriveAccount(%d).Key() = %s, want prefix %s", tt.coinType, prv, tt.prv)
+		}
+		pub, err := account.PublicKey()
+		if err != nil {
+			t.Fatalf("PublicKey() error = %v", err)
+		}
+		if !strings.HasPrefix(pub.String(), tt.pub) {
+			t.Errorf("DeriveAccount(%d).PublicKey() = %s, want prefix %s", tt.coinType, pub.String(), tt.pub)
+		}
+
+		// The serialized key parses back to the same network
+		parsed, err := bip32.ParseExtendedKey(prv)
+		if err != nil {
+			t.Fatalf("ParseExtendedKey(%s) error = %v", prv, err)
+		}
+		if parsed.Network() != account.Key().Network() {
+			t.Errorf("ParseExtendedKey(%s).Network() = %s, want %s", prv, parsed.Network().Name, account.Key().Network().Name)
+		}
+	}
+}
+
 func TestDeriveAddress(t *testing.T) {
 	wallet, _ := NewWalletFromMnemonic(testMnemonic, "")
 
@@ -257,3 +306,407 @@ func TestInvalidMnemonic(t *testing.T) {
 		t.Error("NewWalletFromMnemonic should fail with invalid mnemonic")
 	}
 }
+
+func TestKeyOrigin(t *testing.T) {
+	wallet, _ := NewWalletFromMnemonic(testMnemonic, "")
+
+	path := BitcoinPath(0, 1, 7)
+	fp, derivation, pubKey, err := wallet.KeyOrigin(path)
+	if err != nil {
+		t.Fatalf("KeyOrigin() error = %v", err)
+	}
+
+	neutered, err := wallet.MasterKey().Neuter()
+	if err != nil {
+		t.Fatalf("Neuter() error = %v", err)
+	}
+	if !bytes.Equal(fp[:], neutered.(*bip32.ExtendedKey).Fingerprint()) {
+		t.Errorf("master fingerprint = %x, want %x", fp, neutered.(*bip32.ExtendedKey).Fingerprint())
+	}
+
+	want := path.ToBIP32Path()
+	if len(derivation) != len(want) {
+		t.Fatalf("derivation length = %d, want %d", len(derivation), len(want))
+	}
+	for i := range want {
+		if derivation[i] != want[i] {
+			t.Errorf("derivation[%d] = %d, want %d", i, derivation[i], want[i])
+		}
+	}
+
+	key, _ := wallet.DeriveKey(path)
+	if !bytes.Equal(pubKey, key.PublicKeyBytes()) {
+		t.Errorf("pubKey = %x, want %x", pubKey, key.PublicKeyBytes())
+	}
+}
+
+func TestTraceDerivation(t *testing.T) {
+	seed := bip39.NewSeed(testMnemonic, "")
+	path := EthereumPath(0, 0, 3)
+
+	trace, err := TraceDerivation(seed, path)
+	if err != nil {
+		t.Fatalf("TraceDerivation() error = %v", err)
+	}
+
+	wallet, _ := NewWalletFromSeed(seed)
+	if trace.MasterKey != wallet.MasterKey().String() {
+		t.Errorf("MasterKey = %s, want %s", trace.MasterKey, wallet.MasterKey().String())
+	}
+
+	if len(trace.Steps) != 5 {
+		t.Fatalf("len(Steps) = %d, want 5", len(trace.Steps))
+	}
+
+	if trace.Steps[1].Path != "m/44'/60'" {
+		t.Errorf("Steps[1].Path = %s, want m/44'/60'", trace.Steps[1].Path)
+	}
+
+	key, _ := wallet.DeriveKey(path)
+	if trace.Steps[4].ExtendedKey != key.String() {
+		t.Errorf("last step = %s, want %s", trace.Steps[4].ExtendedKey, key.String())
+	}
+	if !bytes.Equal(trace.PublicKey, key.PublicKeyBytes()) {
+		t.Errorf("PublicKey = %x, want %x", trace.PublicKey, key.PublicKeyBytes())
+	}
+}
+
+func TestVerifyAddress(t *testing.T) {
+	wallet, _ := NewWalletFromMnemonic(testMnemonic, "")
+
+	tests := []struct {
+		chain address.ChainID
+		path  *Path
+		addr  string
+	}{
+		{address.ChainEthereum, EthereumPath(0, 0, 0), "0x9858EfFD232B4033E47d90003D41EC34EcaEda94"},
+		{address.ChainBitcoin, BitcoinPath(0, 0, 0), "1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA"},
+	}
+
+	for _, tt := range tests {
+		ok, err := wallet.VerifyAddress(tt.chain, tt.path, tt.addr)
+		if err != nil {
+			t.Fatalf("VerifyAddress(%s) error = %v", tt.chain, err)
+		}
+		if !ok {
+			t.Errorf("VerifyAddress(%s, %s) = false, want true", tt.chain, tt.addr)
+		}
+
+		ok, err = wallet.VerifyAddress(tt.chain, tt.path.Next(), tt.addr)
+		if err != nil {
+			t.Fatalf("VerifyAddress(%s) error = %v", tt.chain, err)
+		}
+		if ok {
+			t.Errorf("VerifyAddress(%s) at next index = true, want false", tt.chain)
+		}
+	}
+
+	if _, err := wallet.VerifyAddress("unknown", EthereumPath(0, 0, 0), ""); err == nil {
+		t.Error("VerifyAddress() with unknown chain should fail")
+	}
+}
+
+func TestAddressIterator(t *testing.T) {
+	wallet, _ := NewWalletFromMnemonic(testMnemonic, "")
+
+	const n = 5
+	want, err := wallet.DeriveAddresses(CoinTypeEthereum, 0, ExternalChain, 0, n)
+	if err != nil {
+		t.Fatalf("DeriveAddresses() error = %v", err)
+	}
+
+	it, err := wallet.AddressIterator(CoinTypeEthereum, 0, ExternalChain)
+	if err != nil {
+		t.Fatalf("AddressIterator() error = %v", err)
+	}
+
+	for i := 0; i < n; i++ {
+		got, err := it.Next()
+		if err != nil {
+			t.Fatalf("Next() error = %v", err)
+		}
+		if got.Path.String() != want[i].Path.String() {
+			t.Errorf("Next() path = %s, want %s", got.Path, want[i].Path)
+		}
+		if !bytes.Equal(got.PublicKey, want[i].PublicKey) || !bytes.Equal(got.PrivateKey, want[i].PrivateKey) {
+			t.Errorf("Next() key at %d does not match DeriveAddresses()", i)
+		}
+	}
+
+	it.Seek(bip32.HardenedKeyStart)
+	if _, err := it.Next(); err != ErrIteratorExhausted {
+		t.Errorf("Next() past the last index error = %v, want ErrIteratorExhausted", err)
+	}
+}
+
+func TestCompareWallets(t *testing.T) {
+	reference, _ := NewWalletFromMnemonic(testMnemonic, "")
+	same, _ := NewWalletFromMnemonic(testMnemonic, "")
+
+	diffs, err := CompareWallets(reference, same, CoinTypeEthereum, 5)
+	if err != nil {
+		t.Fatalf("CompareWallets() error = %v", err)
+	}
+	if len(diffs) != 0 {
+		t.Errorf("CompareWallets() = %v, want no differences", diffs)
+	}
+
+	// Last word changed, still a valid checksum
+	typo, err := NewWalletFromMnemonic(strings.Replace(testMnemonic, "about", "actual", 1), "")
+	if err != nil {
+		t.Fatalf("NewWalletFromMnemonic() error = %v", err)
+	}
+
+	diffs, err = CompareWallets(reference, typo, CoinTypeEthereum, 5)
+	if err != nil {
+		t.Fatalf("CompareWallets() error = %v", err)
+	}
+	if want := []int{0, 1, 2, 3, 4}; !reflect.DeepEqual(diffs, want) {
+		t.Errorf("CompareWallets() = %v, want %v", diffs, want)
+	}
+
+	if _, err := CompareWallets(reference, nil, CoinTypeEthereum, 5); err != ErrNilWallet {
+		t.Errorf("CompareWallets(nil) error = %v, want %v", err, ErrNilWallet)
+	}
+}
+
+func TestWalletPreview(t *testing.T) {
+	wallet, _ := NewWalletFromMnemonic(testMnemonic, "")
+
+	entries, err := wallet.Preview(address.ChainBitcoin, CoinTypeBitcoin, 0, PreviewRanges{External: 3, Internal: 2})
+	if err != nil {
+		t.Fatalf("Preview() error = %v", err)
+	}
+	if len(entries) != 5 {
+		t.Fatalf("Preview() returned %d entries, want 5", len(entries))
+	}
+
+	wantPaths := []string{
+		"m/44'/0'/0'/0/0", "m/44'/0'/0'/0/1", "m/44'/0'/0'/0/2",
+		"m/44'/0'/0'/1/0", "m/44'/0'/0'/1/1",
+	}
+	for i, entry := range entries {
+		if got := entry.Path.String(); got != wantPaths[i] {
+			t.Errorf("entries[%d].Path = %s, want %s", i, got, wantPaths[i])
+		}
+	}
+
+	if entries[0].Address != "1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA" {
+		t.Errorf("entries[0].Address = %s, want 1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA", entries[0].Address)
+	}
+	want, _ := address.Generate(address.ChainBitcoin, entries[3].PublicKey)
+	if entries[3].Address != want {
+		t.Errorf("entries[3].Address = %s, want %s", entries[3].Address, want)
+	}
+
+	// Start offsets both chains
+	shifted, err := wallet.Preview(address.ChainEthereum, CoinTypeEthereum, 0, PreviewRanges{Start: 5, External: 1, Internal: 1})
+	if err != nil {
+		t.Fatalf("Preview() error = %v", err)
+	}
+	if len(shifted) != 2 || shifted[0].Path.String() != "m/44'/60'/0'/0/5" || shifted[1].Path.String() != "m/44'/60'/0'/1/5" {
+		t.Errorf("Preview(Start: 5) = %v", shifted)
+	}
+}
+
+func TestChainAddresses(t *testing.T) {
+	wallet, _ := NewWalletFromMnemonic(testMnemonic, "")
+
+	tests := []struct {
+		chainID address.ChainID
+		path    string
+		want    string
+	}{
+		{address.ChainBitcoin, "m/44'/0'/0'/0/1", "1Ak8PffB2meyfYnbXZR9EGfLfFZVpzJvQP"},
+		{address.ChainEthereum, "m/44'/60'/0'/0/1", "0x6Fac4D18c912343BF86fa7049364Dd4E424Ab9C0"},
+		{address.ChainSolana, "m/44'/501'/1'/0'", "Hh8QwFUA6MtVu1qAoq12ucvFHNwCcVTV7hpWjeY1Hztb"},
+	}
+
+	for _, tt := range tests {
+		addresses, err := wallet.ChainAddresses(tt.chainID, 0, 2)
+		if err != nil {
+			t.Fatalf("ChainAddresses(%s) error = %v", tt.chainID, err)
+		}
+		if len(addresses) != 2 {
+			t.Fatalf("ChainAddresses(%s) returned %d addresses, want 2", tt.chainID, len(addresses))
+		}
+		if got := addresses[1]; got.Path != tt.path || got.Address != tt.want {
+			t.Errorf("ChainAddresses(%s)[1] = %s %s, want %s %s", tt.chainID, got.Path, got.Address, tt.path, tt.want)
+		}
+	}
+
+	if _, err := wallet.ChainAddresses(address.ChainMonero, 0, 1); err == nil {
+		t.Error("ChainAddresses(xmr) should fail")
+	}
+
+	// Solana enumerates accounts, so account 1 starts where account 0's second
+	// address was and the range cannot spill into hardened indices
+	fromOne, _ := wallet.ChainAddresses(address.ChainSolana, 1, 1)
+	if len(fromOne) != 1 || fromOne[0].Path != "m/44'/501'/1'/0'" {
+		t.Errorf("ChainAddresses(sol, 1, 1) = %v, want m/44'/501'/1'/0'", fromOne)
+	}
+	if _, err := wallet.ChainAddresses(address.ChainSolana, ed25519.HardenedOffset-1, 2); !errors.Is(err, address.ErrInvalidIndex) {
+		t.Errorf("ChainAddresses(sol, 2^31-1, 2) error = %v, want ErrInvalidIndex", err)
+	}
+}
+
+func TestChainAddressesEveryChain(t *testing.T) {
+	wallet, _ := NewWalletFromMnemonic(testMnemonic, "")
+
+	// Chains whose wallets do not use SLIP-10 have no standard path
+	noScheme := map[address.ChainID]bool{
+		address.ChainPolkadot: true,
+		address.ChainKadena:   true,
+		address.ChainCardano:  true,
+		address.ChainWaves:    true,
+	}
+
+	// Every other chain with a known curve derives from a mnemonic
+	for chainID := range address.ChainCurves {
+		addresses, err := wallet.ChainAddresses(chainID, 0, 1)
+		if noScheme[chainID] {
+			if !errors.Is(err, address.ErrUnsupportedChain) {
+				t.Errorf("ChainAddresses(%s) error = %v, want ErrUnsupportedChain", chainID, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ChainAddresses(%s) error = %v", chainID, err)
+			continue
+		}
+		if !address.Validate(chainID, addresses[0].Address) {
+			t.Errorf("ChainAddresses(%s) = %s, which does not validate", chainID, addresses[0].Address)
+		}
+	}
+
+	// Validate cannot tell a wallet's path from any other, so pin every
+	// Ed25519 path to its reference wallet's
+	paths := map[address.ChainID]string{
+		address.ChainEOS:      "m/44'/194'/0'/0/0",
+		address.ChainSolana:   "m/44'/501'/0'/0'",
+		address.ChainStellar:  "m/44'/148'/0'",
+		address.ChainNEAR:     "m/44'/397'/0'",
+		address.ChainTezos:    "m/44'/1729'/0'/0'",
+		address.ChainAlgorand: "m/44'/283'/0'/0'/0'",
+		address.ChainAptos:    "m/44'/637'/0'/0'/0'",
+		address.ChainSui:      "m/44'/784'/0'/0'/0'",
+		address.ChainHedera:   "m/44'/3030'/0'/0'/0'",
+		address.ChainICP:      "m/44'/223'/0'/0'/0'",
+		address.ChainIOTA:     "m/44'/4218'/0'/0'/0'",
+		address.ChainShimmer:  "m/44'/4219'/0'/0'/0'",
+	}
+	for chainID, curve := range address.ChainCurves {
+		if _, ok := paths[chainID]; curve == address.CurveEd25519 && !ok && !noScheme[chainID] {
+			t.Errorf("ChainAddresses(%s) has no pinned path", chainID)
+		}
+	}
+	for chainID, want := range paths {
+		addresses, _ := wallet.ChainAddresses(chainID, 0, 1)
+		if len(addresses) == 0 || addresses[0].Path != want {
+			t.Errorf("ChainAddresses(%s) path = %v, want %s", chainID, addresses, want)
+		}
+	}
+}
+
+func TestWatchOnlyAddresses(t *testing.T) {
+	// BIP-44 account 0 xpub of the test mnemonic
+	const xpub = "xpub6BosfCnifzxcFwrSzQiqu2DBVTshkCXacvNsWGYJVVhhawA7d4R5WSWGFNbi8Aw6ZRc1brxMyWMzG3DSSSSoekkudhUd9yLb6qx39T9nMdj"
+
+	key, err := bip32.ParseExtendedKey(xpub)
+	if err != nil {
+		t.Fatalf("ParseExtendedKey() error = %v", err)
+	}
+
+	addresses, err := WatchOnlyAddresses(key, address.ChainBitcoin, 2)
+	if err != nil {
+		t.Fatalf("WatchOnlyAddresses() error = %v", err)
+	}
+
+	want := []string{"1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA", "1Ak8PffB2meyfYnbXZR9EGfLfFZVpzJvQP"}
+	for i, a := range addresses {
+		if a.Address != want[i] {
+			t.Errorf("WatchOnlyAddresses()[%d] = %s, want %s", i, a.Address, want[i])
+		}
+	}
+
+	// The same addresses the seed derives
+	wallet, _ := NewWalletFromMnemonic(testMnemonic, "")
+	fromSeed, err := wallet.ChainAddresses(address.ChainEthereum, 0, 2)
+	if err != nil {
+		t.Fatalf("ChainAddresses() error = %v", err)
+	}
+	account, _ := wallet.EthereumAccount(0)
+	pub, _ := account.PublicKey()
+	watched, err := WatchOnlyAddresses(pub, address.ChainEthereum, 2)
+	if err != nil {
+		t.Fatalf("WatchOnlyAddresses() error = %v", err)
+	}
+	for i := range watched {
+		if watched[i].Address != fromSeed[i].Address {
+			t.Errorf("WatchOnlyAddresses(eth)[%d] = %s, want %s", i, watched[i].Address, fromSeed[i].Address)
+		}
+	}
+
+	if _, err := WatchOnlyAddresses(key, address.ChainSolana, 1); err == nil {
+		t.Error("WatchOnlyAddresses() should fail for an Ed25519 chain")
+	}
+}
+
+func TestDeriveAddressesParallel(t *testing.T) {
+	wallet, _ := NewWalletFromMnemonic(testMnemonic, "")
+
+	want, err := wallet.DeriveAddresses(CoinTypeEthereum, 0, ExternalChain, 5, 37)
+	if err != nil {
+		t.Fatalf("DeriveAddresses() error = %v", err)
+	}
+
+	for _, workers := range []int{0, 1, 4, 37, 100} {
+		got, err := wallet.DeriveAddressesParallel(CoinTypeEthereum, 0, ExternalChain, 5, 37, workers)
+		if err != nil {
+			t.Fatalf("DeriveAddressesParallel(workers=%d) error = %v", workers, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("DeriveAddressesParallel(workers=%d) differs from DeriveAddresses", workers)
+		}
+	}
+
+	got, err := wallet.DeriveAddressesParallel(CoinTypeEthereum, 0, ExternalChain, 0, 0, 4)
+	if err != nil || len(got) != 0 {
+		t.Errorf("DeriveAddressesParallel(count=0) = %d addresses, %v", len(got), err)
+	}
+}
+
+func TestChangeXPub(t *testing.T) {
+	wallet, _ := NewWalletFromMnemonic(testMnemonic, "")
+	account, _ := wallet.BitcoinAccount(0)
+
+	for _, change := range []uint32{ExternalChain, InternalChain} {
+		xpub, err := account.ChangeXPub(change)
+		if err != nil {
+			t.Fatalf("ChangeXPub() error = %v", err)
+		}
+		changeKey, err := bip32.ParseExtendedKey(xpub)
+		if err != nil {
+			t.Fatalf("ParseExtendedKey() error = %v", err)
+		}
+		if changeKey.IsPrivate() {
+			t.Errorf("ChangeXPub(%d) returned a private key", change)
+		}
+
+		want, err := account.DeriveAddresses(change, 0, 3)
+		if err != nil {
+			t.Fatalf("DeriveAddresses() error = %v", err)
+		}
+		for i, key := range want {
+			child, err := changeKey.Child(uint32(i))
+			if err != nil {
+				t.Fatalf("Child() error = %v", err)
+			}
+			got, _ := address.Generate(address.ChainBitcoin, child.PublicKeyBytes())
+			wantAddr, _ := address.Generate(address.ChainBitcoin, key.PublicKeyBytes())
+			if got != wantAddr {
+				t.Errorf("ChangeXPub(%d) address %d = %s, want %s", change, i, got, wantAddr)
+			}
+		}
+	}
+}
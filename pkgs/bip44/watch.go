@@ -0,0 +1,44 @@
+package bip44
+
+import (
+	"fmt"
+
+	"github.com/study/crypto-accounts/pkgs/address"
+	"github.com/study/crypto-accounts/pkgs/bip32"
+)
+
+// WatchOnlyAddresses derives the first count receiving addresses (0/0 through
+// 0/count-1) below an account-level extended key on a secp256k1 chain. Only
+// public derivation is used, so an xpub is enough: this is how watch-only
+// wallets list the addresses of an account they cannot spend from.
+func WatchOnlyAddresses(accountKey *bip32.ExtendedKey, chainID address.ChainID, count uint32) ([]ChainAddress, error) {
+	if address.ChainCurves[chainID] != address.CurveSecp256k1 {
+		return nil, fmt.Errorf("%w: %s has no public derivation", address.ErrUnsupportedChain, chainID)
+	}
+
+	neutered, err := accountKey.Neuter()
+	if err != nil {
+		return nil, err
+	}
+	receive, err := neutered.Child(ExternalChain)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]ChainAddress, 0, count)
+	for i := uint32(0); i < count; i++ {
+		child, err := receive.Child(i)
+		if err != nil {
+			return nil, err
+		}
+
+		pubKey := child.PublicKeyBytes()
+		addr, err := address.Generate(chainID, pubKey)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, ChainAddress{chainID, address.CurveSecp256k1, fmt.Sprintf("M/%d/%d", ExternalChain, i), addr, pubKey})
+	}
+
+	return addresses, nil
+}
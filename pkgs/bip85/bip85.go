@@ -0,0 +1,132 @@
+// Package bip85 implements BIP-85 deterministic entropy derivation:
+// child mnemonics and other secrets derived from a single BIP-32 master key.
+package bip85
+
+import (
+	"errors"
+
+	"github.com/study/crypto-accounts/pkgs/bip32"
+	"github.com/study/crypto-accounts/pkgs/bip39"
+	"github.com/study/crypto-accounts/pkgs/crypto/encoding"
+	"github.com/study/crypto-accounts/pkgs/crypto/hash"
+)
+
+// Purpose is the BIP-85 purpose level ("DRNG" as a number), hardened in paths
+const Purpose uint32 = 83696968
+
+// Application numbers
+const (
+	AppWIF   uint32 = 2
+	AppBIP39 uint32 = 39
+	AppHex   uint32 = 128169
+)
+
+// wifMainnetPrefix is the WIF version byte for Bitcoin mainnet private keys
+const wifMainnetPrefix byte = 0x80
+
+// LanguageEnglish is the BIP-85 language code for the English word list
+const LanguageEnglish uint32 = 0
+
+var (
+	// ErrPublicKey is returned when a public extended key is used for derivation
+	ErrPublicKey = errors.New("bip85: master key must be private")
+
+	// ErrUnsupportedLanguage is returned for a language without a word list
+	ErrUnsupportedLanguage = errors.New("bip85: unsupported mnemonic language")
+
+	// ErrInvalidWordCount is returned for word counts other than 12, 18 or 24
+	ErrInvalidWordCount = errors.New("bip85: word count must be 12, 18 or 24")
+
+	// ErrInvalidLength is returned for hex output lengths outside 16-64 bytes
+	ErrInvalidLength = errors.New("bip85: hex length must be between 16 and 64 bytes")
+)
+
+// entropyKey is the HMAC key used to extract entropy from a derived private key
+var entropyKey = []byte("bip-entropy-from-k")
+
+// WordLists maps BIP-85 language codes to the available BIP-39 word lists
+var WordLists = map[uint32]bip39.WordList{
+	LanguageEnglish: bip39.English,
+}
+
+// DeriveEntropy derives the 64-byte BIP-85 entropy at path (indices already hardened):
+// HMAC-SHA512(key = "bip-entropy-from-k", msg = private key at path).
+func DeriveEntropy(master *bip32.ExtendedKey, path bip32.DerivationPath) ([]byte, error) {
+	if !master.IsPrivate() {
+		return nil, ErrPublicKey
+	}
+
+	child, err := master.DeriveFromPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return hash.HMACSHA512(entropyKey, child.PrivateKeyBytes()), nil
+}
+
+// hardenedPath builds m/83696968'/indices'... with every level hardened
+func hardenedPath(indices ...uint32) bip32.DerivationPath {
+	path := make(bip32.DerivationPath, 0, len(indices)+1)
+	path = append(path, bip32.Hardened(Purpose))
+	for _, idx := range indices {
+		path = append(path, bip32.Hardened(idx))
+	}
+	return path
+}
+
+// DeriveMnemonic derives a child BIP-39 mnemonic at m/83696968'/39'/language'/words'/index'
+func DeriveMnemonic(master *bip32.ExtendedKey, language, words, index uint32) (string, error) {
+	wordList, ok := WordLists[language]
+	if !ok {
+		return "", ErrUnsupportedLanguage
+	}
+
+	var entropyLen int
+	switch words {
+	case 12:
+		entropyLen = 16
+	case 18:
+		entropyLen = 24
+	case 24:
+		entropyLen = 32
+	default:
+		return "", ErrInvalidWordCount
+	}
+
+	entropy, err := DeriveEntropy(master, hardenedPath(AppBIP39, language, words, index))
+	if err != nil {
+		return "", err
+	}
+
+	return bip39.NewMnemonicWithWordList(entropy[:entropyLen], wordList)
+}
+
+// DeriveWIF derives a compressed mainnet WIF private key at m/83696968'/2'/index'
+func DeriveWIF(master *bip32.ExtendedKey, index uint32) (string, error) {
+	entropy, err := DeriveEntropy(master, hardenedPath(AppWIF, index))
+	if err != nil {
+		return "", err
+	}
+
+	// version || key || compression flag
+	payload := make([]byte, 0, 34)
+	payload = append(payload, wifMainnetPrefix)
+	payload = append(payload, entropy[:32]...)
+	payload = append(payload, 0x01)
+
+	return encoding.Base58CheckEncode(payload), nil
+}
+
+// DeriveHex derives numBytes (16-64) of raw entropy at m/83696968'/128169'/numBytes'/index'
+func DeriveHex(master *bip32.ExtendedKey, numBytes, index uint32) ([]byte, error) {
+	if numBytes < 16 || numBytes > 64 {
+		return nil, ErrInvalidLength
+	}
+
+	entropy, err := DeriveEntropy(master, hardenedPath(AppHex, numBytes, index))
+	if err != nil {
+		return nil, err
+	}
+
+	return entropy[:numBytes], nil
+}
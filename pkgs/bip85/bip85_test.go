@@ -0,0 +1,100 @@
+package bip85
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/study/crypto-accounts/pkgs/bip32"
+)
+
+// BIP-85 reference master key
+const testMasterKey = "xprv9s21ZrQH143K2LBWUUQRFXhucrQqBpKdRRxNVq2zBqsx8HVqFk2uYo8kmbaLLHRdqtQpUm98uKfu3vca1LqdGhUtyoFnCNkfmXRyPXLjbKb"
+
+func testMaster(t *testing.T) *bip32.ExtendedKey {
+	t.Helper()
+	master, err := bip32.ParseExtendedKey(testMasterKey)
+	if err != nil {
+		t.Fatalf("ParseExtendedKey() error = %v", err)
+	}
+	return master
+}
+
+func TestDeriveEntropy(t *testing.T) {
+	master := testMaster(t)
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"m/83696968'/0'/0'", "efecfbccffea313214232d29e71563d941229afb4338c21f9517c41aaa0d16f00b83d2a09ef747e7a64e8e2bd5a14869e693da66ce94ac2da570ab7ee48618f7"},
+		{"m/83696968'/0'/1'", "70c6e3e8ebee8dc4c0dbba66076819bb8c09672527c4277ca8729532ad711872218f826919f6b67218adde99018a6df9095ab2b58d803b5b93ec9802085a690e"},
+	}
+
+	for _, tt := range tests {
+		entropy, err := DeriveEntropy(master, bip32.MustParsePath(tt.path))
+		if err != nil {
+			t.Fatalf("DeriveEntropy(%s) error = %v", tt.path, err)
+		}
+		if hex.EncodeToString(entropy) != tt.want {
+			t.Errorf("DeriveEntropy(%s) = %x, want %s", tt.path, entropy, tt.want)
+		}
+	}
+}
+
+func TestDeriveMnemonic(t *testing.T) {
+	master := testMaster(t)
+
+	mnemonic, err := DeriveMnemonic(master, LanguageEnglish, 12, 0)
+	if err != nil {
+		t.Fatalf("DeriveMnemonic() error = %v", err)
+	}
+
+	want := "girl mad pet galaxy egg matter matrix prison refuse sense ordinary nose"
+	if mnemonic != want {
+		t.Errorf("DeriveMnemonic() = %s, want %s", mnemonic, want)
+	}
+
+	if _, err := DeriveMnemonic(master, LanguageEnglish, 15, 0); err != ErrInvalidWordCount {
+		t.Errorf("DeriveMnemonic(15 words) error = %v, want ErrInvalidWordCount", err)
+	}
+	if _, err := DeriveMnemonic(master, 9, 12, 0); err != ErrUnsupportedLanguage {
+		t.Errorf("DeriveMnemonic(language 9) error = %v, want ErrUnsupportedLanguage", err)
+	}
+
+	pub, _ := master.Neuter()
+	if _, err := DeriveMnemonic(pub.(*bip32.ExtendedKey), LanguageEnglish, 12, 0); err != ErrPublicKey {
+		t.Errorf("DeriveMnemonic(public key) error = %v, want ErrPublicKey", err)
+	}
+}
+
+func TestDeriveWIF(t *testing.T) {
+	wif, err := DeriveWIF(testMaster(t), 0)
+	if err != nil {
+		t.Fatalf("DeriveWIF() error = %v", err)
+	}
+
+	want := "Kzyv4uF39d4Jrw2W7UryTHwZr1zQVNk4dAFyqE6BuMrMh1Za7uhp"
+	if wif != want {
+		t.Errorf("DeriveWIF() = %s, want %s", wif, want)
+	}
+}
+
+func TestDeriveHex(t *testing.T) {
+	master := testMaster(t)
+
+	entropy, err := DeriveHex(master, 64, 0)
+	if err != nil {
+		t.Fatalf("DeriveHex() error = %v", err)
+	}
+
+	want := "492db4698cf3b73a5a24998aa3e9d7fa96275d85724a91e71aa2d645442f878555d078fd1f1f67e368976f04137b1f7a0d19232136ca50c44614af72b5582a5c"
+	if hex.EncodeToString(entropy) != want {
+		t.Errorf("DeriveHex() = %x, want %s", entropy, want)
+	}
+
+	for _, n := range []uint32{15, 65} {
+		if _, err := DeriveHex(master, n, 0); err != ErrInvalidLength {
+			t.Errorf("DeriveHex(%d bytes) error = %v, want ErrInvalidLength", n, err)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+// Package checksum provides the CRC checksums used by cryptocurrency address formats.
+package checksum
+
+import (
+	"hash/crc32"
+)
+
+// crc16Poly is the CCITT polynomial x^16 + x^12 + x^5 + 1
+const crc16Poly = 0x1021
+
+// CRC16XModem computes CRC-16/XMODEM (poly 0x1021, init 0x0000), used by Stellar and TON.
+func CRC16XModem(data []byte) uint16 {
+	return crc16(data, 0x0000)
+}
+
+// CRC16CCITT computes CRC-16/CCITT-FALSE (poly 0x1021, init 0xFFFF).
+func CRC16CCITT(data []byte) uint16 {
+	return crc16(data, 0xFFFF)
+}
+
+// CRC32ISO computes CRC-32/ISO-HDLC, the zlib/Ethernet CRC-32, used by ICP principals.
+func CRC32ISO(data []byte) uint32 {
+	return crc32.ChecksumIEEE(data)
+}
+
+// crc16 computes a non-reflected CRC-16 with the CCITT polynomial and no final XOR
+func crc16(data []byte, crc uint16) uint16 {
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = (crc << 1) ^ crc16Poly
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,45 @@
+package checksum
+
+import "testing"
+
+// Check values are the CRC of the ASCII string "123456789"
+var checkInput = []byte("123456789")
+
+func TestCRC16XModem(t *testing.T) {
+	if got := CRC16XModem(checkInput); got != 0x31C3 {
+		t.Errorf("CRC16XModem() = %#04x, want 0x31c3", got)
+	}
+	if got := CRC16XModem(nil); got != 0 {
+		t.Errorf("CRC16XModem(nil) = %#04x, want 0", got)
+	}
+}
+
+func TestCRC16CCITT(t *testing.T) {
+	if got := CRC16CCITT(checkInput); got != 0x29B1 {
+		t.Errorf("CRC16CCITT() = %#04x, want 0x29b1", got)
+	}
+	if got := CRC16CCITT(nil); got != 0xFFFF {
+		t.Errorf("CRC16CCITT(nil) = %#04x, want 0xffff", got)
+	}
+}
+
+func TestCRC32ISO(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		want  uint32
+	}{
+		{"check", checkInput, 0xCBF43926},
+		{"empty", nil, 0},
+		// ICP anonymous principal 2vxsx-fae
+		{"icp anonymous", []byte{0x04}, 0xD56F2B94},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CRC32ISO(tt.input); got != tt.want {
+				t.Errorf("CRC32ISO() = %#08x, want %#08x", got, tt.want)
+			}
+		})
+	}
+}
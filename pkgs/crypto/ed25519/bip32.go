@@ -0,0 +1,73 @@
+package ed25519
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+)
+
+// ExtendedPublicKeySize is the size of a BIP32-Ed25519 extended public key (public key || chain code)
+const ExtendedPublicKeySize = 64
+
+// ErrHardenedPublicDerivation is returned when a hardened index is requested from a public key.
+var ErrHardenedPublicDerivation = errors.New("cannot derive hardened child from public key")
+
+// DerivePublicChild performs BIP32-Ed25519 (Khovratovich-Law, as used by Cardano's
+// CIP-1852) soft derivation of a child public key and chain code.
+//
+//	Z   = HMAC-SHA512(chainCode, 0x02 || A || LE32(index))
+//	A_i = A + 8*ZL*B, where ZL is the first 28 bytes of Z
+//	c_i = HMAC-SHA512(chainCode, 0x03 || A || LE32(index))[32:]
+func DerivePublicChild(publicKey, chainCode []byte, index uint32) ([]byte, []byte, error) {
+	if len(publicKey) != PublicKeySize {
+		return nil, nil, ErrInvalidPublicKey
+	}
+	if len(chainCode) != 32 {
+		return nil, nil, errors.New("chain code must be 32 bytes")
+	}
+	if index >= 0x80000000 {
+		return nil, nil, ErrHardenedPublicDerivation
+	}
+
+	parent, err := DecodePoint(publicKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data := make([]byte, 1+PublicKeySize+4)
+	copy(data[1:], publicKey)
+	binary.LittleEndian.PutUint32(data[1+PublicKeySize:], index)
+
+	data[0] = 0x02
+	z := hmacSHA512(chainCode, data)
+	data[0] = 0x03
+	c := hmacSHA512(chainCode, data)
+
+	zl := leToInt(z[:28])
+	zl.Mul(zl, big.NewInt(8))
+	child := AddPoints(parent, ScalarMult(BasePoint(), zl))
+
+	return child.Bytes(), c[32:], nil
+}
+
+// DerivePublicPath applies DerivePublicChild for each index of a soft derivation path.
+// The input and output are 64-byte extended public keys (public key || chain code).
+func DerivePublicPath(extendedPublicKey []byte, path []uint32) ([]byte, error) {
+	if len(extendedPublicKey) != ExtendedPublicKeySize {
+		return nil, errors.New("extended public key must be 64 bytes")
+	}
+
+	pub := extendedPublicKey[:PublicKeySize]
+	chainCode := extendedPublicKey[PublicKeySize:]
+	for _, index := range path {
+		var err error
+		pub, chainCode, err = DerivePublicChild(pub, chainCode, index)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	out := make([]byte, 0, ExtendedPublicKeySize)
+	out = append(out, pub...)
+	return append(out, chainCode...), nil
+}
@@ -3,6 +3,7 @@
 package ed25519
 
 import (
+	"bytes"
 	"crypto/ed25519"
 	"crypto/sha512"
 	"errors"
@@ -26,6 +27,7 @@ var (
 	ErrInvalidPrivateKey = errors.New("invalid private key: must be 32 bytes")
 	ErrInvalidPublicKey  = errors.New("invalid public key: must be 32 bytes")
 	ErrInvalidSignature  = errors.New("invalid signature")
+	ErrInvalidIndex      = errors.New("invalid index: must be below 2^31")
 )
 
 // PrivateKeyToPublicKey derives an Ed25519 public key from a 32-byte private key (seed).
@@ -53,6 +55,26 @@ func ExpandPrivateKey(seed []byte) ([]byte, error) {
 	return ed25519.NewKeyFromSeed(seed), nil
 }
 
+// SeedFromExpanded returns the 32-byte seed of a 64-byte expanded private key
+// (seed || public key), as some wallets export it. The public key half must
+// match the seed.
+func SeedFromExpanded(expanded []byte) ([]byte, error) {
+	if len(expanded) != ExpandedPrivateKeySize {
+		return nil, ErrInvalidPrivateKey
+	}
+
+	seed := expanded[:PrivateKeySize]
+	publicKey, err := PrivateKeyToPublicKey(seed)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(publicKey, expanded[PrivateKeySize:]) {
+		return nil, ErrInvalidPrivateKey
+	}
+
+	return seed, nil
+}
+
 // Sign signs a message with the given private key (seed).
 func Sign(privateKey, message []byte) ([]byte, error) {
 	if len(privateKey) != PrivateKeySize {
@@ -114,6 +136,41 @@ func DeriveKeyFromPath(seed []byte, path []uint32) ([]byte, []byte, error) {
 	return key, publicKey, nil
 }
 
+// HardenedOffset is added to an index to mark it as hardened.
+const HardenedOffset uint32 = 0x80000000
+
+// Purpose44 is the BIP-44 purpose level.
+const Purpose44 uint32 = 44
+
+// HardenedPath returns a SLIP-10 path with every index hardened.
+func HardenedPath(indices ...uint32) []uint32 {
+	path := make([]uint32, len(indices))
+	for i, index := range indices {
+		path[i] = index | HardenedOffset
+	}
+	return path
+}
+
+// AccountKey derives the key pair at the fully-hardened BIP-44 path
+// m/44'/coinType'/account'/change'/index' using SLIP-10.
+func AccountKey(seed []byte, coinType, account, change, index uint32) (priv, pub []byte, err error) {
+	return BIP44Key(seed, coinType, account, change, index)
+}
+
+// BIP44Key derives the key pair at m/44'/coinType' followed by the given
+// levels, all hardened, for wallets that stop short of the 5-level layout:
+// BIP44Key(seed, 501, i, 0) is Phantom's m/44'/501'/i'/0' and
+// BIP44Key(seed, 148, i) is Stellar's m/44'/148'/i'.
+func BIP44Key(seed []byte, coinType uint32, levels ...uint32) (priv, pub []byte, err error) {
+	indices := append([]uint32{Purpose44, coinType}, levels...)
+	for _, index := range indices {
+		if index >= HardenedOffset {
+			return nil, nil, ErrInvalidIndex
+		}
+	}
+	return DeriveKeyFromPath(seed, HardenedPath(indices...))
+}
+
 // slip10MasterKey derives the master key and chain code from seed using SLIP-10.
 func slip10MasterKey(seed []byte) ([]byte, []byte) {
 	// HMAC-SHA512 with key "ed25519 seed"
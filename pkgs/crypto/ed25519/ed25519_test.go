@@ -3,6 +3,8 @@ package ed25519
 import (
 	"encoding/hex"
 	"testing"
+
+	"github.com/study/crypto-accounts/pkgs/crypto/encoding"
 )
 
 func TestPrivateKeyToPublicKey(t *testing.T) {
@@ -117,3 +119,93 @@ func TestInvalidInputs(t *testing.T) {
 		t.Error("Should fail with invalid signature size")
 	}
 }
+
+func TestAccountKey(t *testing.T) {
+	// Test vector: "abandon ... about" mnemonic, empty passphrase
+	seed, _ := hex.DecodeString("5eb00bbddcf069084889a8ab9155568165f5c453ccb85e70811aaed6f6da5fc19a5ac40b389cd370d086206dec8aa6c43daea6690f20ad3d8d48b2d2ce9e38e4")
+
+	priv, pub, err := AccountKey(seed, 501, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("AccountKey() error = %v", err)
+	}
+
+	wantPriv, wantPub, _ := DeriveKeyFromPath(seed, []uint32{
+		0x80000000 + 44, 0x80000000 + 501, 0x80000000, 0x80000000, 0x80000000,
+	})
+	if hex.EncodeToString(priv) != hex.EncodeToString(wantPriv) || hex.EncodeToString(pub) != hex.EncodeToString(wantPub) {
+		t.Error("AccountKey() does not match m/44'/501'/0'/0'/0'")
+	}
+
+	if _, _, err := AccountKey(seed, 501, HardenedOffset, 0, 0); err != ErrInvalidIndex {
+		t.Errorf("AccountKey(2^31) error = %v, want %v", err, ErrInvalidIndex)
+	}
+}
+
+func TestBIP44Key(t *testing.T) {
+	// Test vector: "abandon ... about" mnemonic, empty passphrase
+	seed, _ := hex.DecodeString("5eb00bbddcf069084889a8ab9155568165f5c453ccb85e70811aaed6f6da5fc19a5ac40b389cd370d086206dec8aa6c43daea6690f20ad3d8d48b2d2ce9e38e4")
+
+	// Phantom/Solflare enumerate accounts at m/44'/501'/i'/0'
+	for i, want := range []string{
+		"HAgk14JpMQLgt6rVgv7cBQFJWFto5Dqxi472uT3DKpqk",
+		"Hh8QwFUA6MtVu1qAoq12ucvFHNwCcVTV7hpWjeY1Hztb",
+	} {
+		_, pub, err := BIP44Key(seed, 501, uint32(i), 0)
+		if err != nil {
+			t.Fatalf("BIP44Key(501, %d, 0) error = %v", i, err)
+		}
+		if got := encoding.Base58Encode(pub); got != want {
+			t.Errorf("m/44'/501'/%d'/0' address = %s, want %s", i, got, want)
+		}
+	}
+
+	// The 5-level form matches AccountKey
+	_, pub, _ := BIP44Key(seed, 501, 0, 0, 0)
+	_, accountPub, _ := AccountKey(seed, 501, 0, 0, 0)
+	if hex.EncodeToString(pub) != hex.EncodeToString(accountPub) {
+		t.Error("BIP44Key(501, 0, 0, 0) does not match AccountKey()")
+	}
+
+	if _, _, err := BIP44Key(seed, HardenedOffset|501, 0, 0); err != ErrInvalidIndex {
+		t.Errorf("BIP44Key(hardened coin type) error = %v, want %v", err, ErrInvalidIndex)
+	}
+}
+
+func TestHardenedPath(t *testing.T) {
+	path := HardenedPath(44, 501, 0x80000000)
+	want := []uint32{0x8000002c, 0x800001f5, 0x80000000}
+	for i := range want {
+		if path[i] != want[i] {
+			t.Errorf("HardenedPath()[%d] = %x, want %x", i, path[i], want[i])
+		}
+	}
+}
+
+func TestSeedFromExpanded(t *testing.T) {
+	seed, _ := hex.DecodeString("9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60")
+	expanded, _ := ExpandPrivateKey(seed)
+
+	got, err := SeedFromExpanded(expanded)
+	if err != nil {
+		t.Fatalf("SeedFromExpanded() error = %v", err)
+	}
+	if hex.EncodeToString(got) != hex.EncodeToString(seed) {
+		t.Errorf("SeedFromExpanded() = %x, want %x", got, seed)
+	}
+
+	fromSeed, _ := PrivateKeyToPublicKey(seed)
+	fromExpanded, _ := PrivateKeyToPublicKey(got)
+	if hex.EncodeToString(fromExpanded) != hex.EncodeToString(fromSeed) {
+		t.Errorf("public key from expanded key = %x, want %x", fromExpanded, fromSeed)
+	}
+
+	// A public key half that doesn't belong to the seed is rejected
+	tampered := append([]byte{}, expanded...)
+	tampered[63] ^= 1
+	if _, err := SeedFromExpanded(tampered); err != ErrInvalidPrivateKey {
+		t.Errorf("SeedFromExpanded(tampered) error = %v, want %v", err, ErrInvalidPrivateKey)
+	}
+	if _, err := SeedFromExpanded(seed); err != ErrInvalidPrivateKey {
+		t.Errorf("SeedFromExpanded(32 bytes) error = %v, want %v", err, ErrInvalidPrivateKey)
+	}
+}
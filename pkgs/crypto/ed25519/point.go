@@ -0,0 +1,182 @@
+package ed25519
+
+import (
+	"errors"
+	"math/big"
+)
+
+// Curve parameters for edwards25519: -x^2 + y^2 = 1 + d*x^2*y^2 over GF(P)
+var (
+	// P is the prime field of the curve (2^255 - 19)
+	P, _ = new(big.Int).SetString("7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffed", 16)
+
+	// L is the order of the prime-order subgroup generated by the base point
+	L, _ = new(big.Int).SetString("1000000000000000000000000000000014def9dea2f79cd65812631a5cf5d3ed", 16)
+
+	// D is the curve constant -121665/121666 mod P
+	D, _ = new(big.Int).SetString("52036cee2b6ffe738cc740797779e89800700a4d4141d8ab75eb4dca135978a3", 16)
+
+	// Bx is the x-coordinate of the base point
+	Bx, _ = new(big.Int).SetString("216936d3cd6e53fec0a4e231fdd6dc5c692cc7609525a7b2c9562d608f25d51a", 16)
+
+	// By is the y-coordinate of the base point (4/5 mod P)
+	By, _ = new(big.Int).SetString("6666666666666666666666666666666666666666666666666666666666666658", 16)
+
+	// sqrtM1 is a square root of -1 mod P, used during point decompression
+	sqrtM1, _ = new(big.Int).SetString("2b8324804fc1df0b2b4d00993dfbd7a72f431806ad2fe478c4ee1b274a0ea0b0", 16)
+)
+
+// ErrInvalidPoint is returned when bytes do not decode to a point on the curve.
+var ErrInvalidPoint = errors.New("invalid point: not on edwards25519")
+
+// Point represents a point on the edwards25519 curve in affine coordinates.
+type Point struct {
+	X, Y *big.Int
+}
+
+// BasePoint returns the standard base point B of edwards25519.
+func BasePoint() *Point {
+	return &Point{
+		X: new(big.Int).Set(Bx),
+		Y: new(big.Int).Set(By),
+	}
+}
+
+// Identity returns the neutral element (0, 1).
+func Identity() *Point {
+	return &Point{
+		X: big.NewInt(0),
+		Y: big.NewInt(1),
+	}
+}
+
+// Equal returns true if two points are equal.
+func (p *Point) Equal(other *Point) bool {
+	return p.X.Cmp(other.X) == 0 && p.Y.Cmp(other.Y) == 0
+}
+
+// AddPoints performs point addition using the complete twisted Edwards formula.
+func AddPoints(p1, p2 *Point) *Point {
+	// t = d * x1 * x2 * y1 * y2
+	x1x2 := new(big.Int).Mul(p1.X, p2.X)
+	y1y2 := new(big.Int).Mul(p1.Y, p2.Y)
+	t := new(big.Int).Mul(x1x2, y1y2)
+	t.Mul(t, D)
+	t.Mod(t, P)
+
+	// x3 = (x1*y2 + y1*x2) / (1 + t)
+	xNum := new(big.Int).Mul(p1.X, p2.Y)
+	xNum.Add(xNum, new(big.Int).Mul(p1.Y, p2.X))
+	xDen := new(big.Int).Add(big.NewInt(1), t)
+	xDen.ModInverse(xDen, P)
+	x3 := xNum.Mul(xNum, xDen)
+	x3.Mod(x3, P)
+
+	// y3 = (y1*y2 + x1*x2) / (1 - t)
+	yNum := y1y2.Add(y1y2, x1x2)
+	yDen := new(big.Int).Sub(big.NewInt(1), t)
+	yDen.Mod(yDen, P)
+	yDen.ModInverse(yDen, P)
+	y3 := yNum.Mul(yNum, yDen)
+	y3.Mod(y3, P)
+
+	return &Point{X: x3, Y: y3}
+}
+
+// ScalarMult computes k * P using double-and-add.
+func ScalarMult(p *Point, k *big.Int) *Point {
+	result := Identity()
+	addend := &Point{X: new(big.Int).Set(p.X), Y: new(big.Int).Set(p.Y)}
+
+	for i := 0; i < k.BitLen(); i++ {
+		if k.Bit(i) == 1 {
+			result = AddPoints(result, addend)
+		}
+		addend = AddPoints(addend, addend)
+	}
+
+	return result
+}
+
+// ScalarBaseMult computes k * B where k is a little-endian scalar.
+func ScalarBaseMult(k []byte) *Point {
+	return ScalarMult(BasePoint(), leToInt(k))
+}
+
+// Bytes returns the 32-byte compressed encoding of the point:
+// little-endian y with the sign of x in the top bit.
+func (p *Point) Bytes() []byte {
+	out := make([]byte, 32)
+	yBytes := p.Y.Bytes()
+	for i, b := range yBytes {
+		out[len(yBytes)-1-i] = b
+	}
+	if p.X.Bit(0) == 1 {
+		out[31] |= 0x80
+	}
+	return out
+}
+
+// DecodePoint decodes a 32-byte compressed point.
+// It returns ErrInvalidPoint if the encoding is non-canonical or no x exists for y.
+func DecodePoint(data []byte) (*Point, error) {
+	if len(data) != 32 {
+		return nil, ErrInvalidPublicKey
+	}
+
+	buf := make([]byte, 32)
+	copy(buf, data)
+	sign := buf[31] >> 7
+	buf[31] &= 0x7f
+
+	y := leToInt(buf)
+	if y.Cmp(P) >= 0 {
+		return nil, ErrInvalidPoint
+	}
+
+	// x^2 = (y^2 - 1) / (d*y^2 + 1)
+	y2 := new(big.Int).Mul(y, y)
+	y2.Mod(y2, P)
+	u := new(big.Int).Sub(y2, big.NewInt(1))
+	u.Mod(u, P)
+	v := new(big.Int).Mul(D, y2)
+	v.Add(v, big.NewInt(1))
+	v.Mod(v, P)
+	x2 := new(big.Int).Mul(u, new(big.Int).ModInverse(v, P))
+	x2.Mod(x2, P)
+
+	// Candidate root x = x2^((P+3)/8)
+	exp := new(big.Int).Add(P, big.NewInt(3))
+	exp.Rsh(exp, 3)
+	x := new(big.Int).Exp(x2, exp, P)
+
+	check := new(big.Int).Mul(x, x)
+	check.Mod(check, P)
+	if check.Cmp(x2) != 0 {
+		x.Mul(x, sqrtM1)
+		x.Mod(x, P)
+		check.Mul(x, x)
+		check.Mod(check, P)
+		if check.Cmp(x2) != 0 {
+			return nil, ErrInvalidPoint
+		}
+	}
+
+	if x.Sign() == 0 && sign == 1 {
+		return nil, ErrInvalidPoint
+	}
+	if x.Bit(0) != uint(sign) {
+		x.Sub(P, x)
+	}
+
+	return &Point{X: x, Y: y}, nil
+}
+
+// leToInt interprets a byte slice as a little-endian unsigned integer.
+func leToInt(b []byte) *big.Int {
+	be := make([]byte, len(b))
+	for i := range b {
+		be[len(b)-1-i] = b[i]
+	}
+	return new(big.Int).SetBytes(be)
+}
@@ -0,0 +1,98 @@
+package ed25519
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/hex"
+	"math/big"
+	"testing"
+)
+
+func TestBasePointEncoding(t *testing.T) {
+	want := "5866666666666666666666666666666666666666666666666666666666666666"
+	if got := hex.EncodeToString(BasePoint().Bytes()); got != want {
+		t.Errorf("BasePoint().Bytes() = %s, want %s", got, want)
+	}
+}
+
+func TestScalarBaseMultMatchesStdlib(t *testing.T) {
+	seed, _ := hex.DecodeString("9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60")
+
+	// RFC 8032 key expansion: clamp the lower half of SHA-512(seed)
+	h := sha512.Sum512(seed)
+	h[0] &= 248
+	h[31] &= 127
+	h[31] |= 64
+
+	want, _ := PrivateKeyToPublicKey(seed)
+	got := ScalarBaseMult(h[:32]).Bytes()
+	if !bytes.Equal(got, want) {
+		t.Errorf("ScalarBaseMult() = %x, want %x", got, want)
+	}
+}
+
+func TestDecodePointRoundTrip(t *testing.T) {
+	pubKey, _ := hex.DecodeString("d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a")
+
+	p, err := DecodePoint(pubKey)
+	if err != nil {
+		t.Fatalf("DecodePoint() error = %v", err)
+	}
+	if !bytes.Equal(p.Bytes(), pubKey) {
+		t.Errorf("Bytes() = %x, want %x", p.Bytes(), pubKey)
+	}
+}
+
+func TestDecodePointInvalid(t *testing.T) {
+	// y = 2 has no corresponding x on the curve
+	data := make([]byte, 32)
+	data[0] = 2
+	if _, err := DecodePoint(data); err != ErrInvalidPoint {
+		t.Errorf("DecodePoint() error = %v, want %v", err, ErrInvalidPoint)
+	}
+
+	if _, err := DecodePoint(make([]byte, 31)); err == nil {
+		t.Error("DecodePoint() should fail for 31 bytes")
+	}
+}
+
+func TestScalarMultOrder(t *testing.T) {
+	if !ScalarMult(BasePoint(), L).Equal(Identity()) {
+		t.Error("L * B should be the identity")
+	}
+
+	two := ScalarMult(BasePoint(), big.NewInt(2))
+	if !two.Equal(AddPoints(BasePoint(), BasePoint())) {
+		t.Error("2 * B should equal B + B")
+	}
+}
+
+func TestDerivePublicChild(t *testing.T) {
+	kL := make([]byte, 32)
+	kL[0] = 0x08
+	kL[31] = 0x40
+	chainCode := bytes.Repeat([]byte{0x01}, 32)
+	pub := ScalarBaseMult(kL).Bytes()
+
+	childPub, childChain, err := DerivePublicChild(pub, chainCode, 0)
+	if err != nil {
+		t.Fatalf("DerivePublicChild() error = %v", err)
+	}
+	if len(childChain) != 32 {
+		t.Errorf("chain code length = %d, want 32", len(childChain))
+	}
+
+	// The private side computes kL_i = kL + 8*ZL; its public key must match.
+	data := append([]byte{0x02}, pub...)
+	data = append(data, 0, 0, 0, 0)
+	z := hmacSHA512(chainCode, data)
+	scalar := new(big.Int).Add(leToInt(kL), new(big.Int).Mul(leToInt(z[:28]), big.NewInt(8)))
+	want := ScalarMult(BasePoint(), scalar).Bytes()
+	if !bytes.Equal(childPub, want) {
+		t.Errorf("child public key = %x, want %x", childPub, want)
+	}
+
+	if _, _, err := DerivePublicChild(pub, chainCode, 0x80000000); err != ErrHardenedPublicDerivation {
+		t.Errorf("hardened index error = %v, want %v", err, ErrHardenedPublicDerivation)
+	}
+}
@@ -0,0 +1,82 @@
+package ed25519
+
+import (
+	"errors"
+
+	"filippo.io/edwards25519"
+)
+
+// ScalarSize is the size of an encoded edwards25519 scalar or point
+const ScalarSize = 32
+
+// ErrInvalidScalar is returned for scalars that are not 32 bytes or not reduced modulo L
+var ErrInvalidScalar = errors.New("invalid scalar: must be 32 bytes and less than L")
+
+// The functions below take secret scalars and are constant-time, by
+// filippo.io/edwards25519. Point and ScalarMult above use big.Int and must
+// only see public values.
+
+// ReduceScalar reduces a little-endian integer of up to 64 bytes modulo L,
+// as Monero's hash-to-scalar does with a 32-byte Keccak digest.
+func ReduceScalar(b []byte) ([]byte, error) {
+	if len(b) > 64 {
+		return nil, ErrInvalidScalar
+	}
+
+	wide := make([]byte, 64)
+	copy(wide, b)
+	s, err := edwards25519.NewScalar().SetUniformBytes(wide)
+	if err != nil {
+		return nil, err
+	}
+	return s.Bytes(), nil
+}
+
+// ScalarBaseMultCT returns the encoding of scalar * B for a reduced
+// little-endian scalar, in constant time.
+func ScalarBaseMultCT(scalar []byte) ([]byte, error) {
+	s, err := decodeScalar(scalar)
+	if err != nil {
+		return nil, err
+	}
+	return new(edwards25519.Point).ScalarBaseMult(s).Bytes(), nil
+}
+
+// ScalarMultCT returns the encoding of scalar * point for a reduced
+// little-endian scalar and an encoded point, in constant time.
+func ScalarMultCT(scalar, point []byte) ([]byte, error) {
+	s, err := decodeScalar(scalar)
+	if err != nil {
+		return nil, err
+	}
+	p, err := new(edwards25519.Point).SetBytes(point)
+	if err != nil {
+		return nil, ErrInvalidPoint
+	}
+	return new(edwards25519.Point).ScalarMult(s, p).Bytes(), nil
+}
+
+// AddEncodedPoints returns the encoding of p + q for two encoded points
+func AddEncodedPoints(p, q []byte) ([]byte, error) {
+	a, err := new(edwards25519.Point).SetBytes(p)
+	if err != nil {
+		return nil, ErrInvalidPoint
+	}
+	b, err := new(edwards25519.Point).SetBytes(q)
+	if err != nil {
+		return nil, ErrInvalidPoint
+	}
+	return new(edwards25519.Point).Add(a, b).Bytes(), nil
+}
+
+// decodeScalar parses a canonical 32-byte little-endian scalar
+func decodeScalar(b []byte) (*edwards25519.Scalar, error) {
+	if len(b) != ScalarSize {
+		return nil, ErrInvalidScalar
+	}
+	s, err := edwards25519.NewScalar().SetCanonicalBytes(b)
+	if err != nil {
+		return nil, ErrInvalidScalar
+	}
+	return s, nil
+}
@@ -0,0 +1,63 @@
+package ed25519
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"errors"
+	"testing"
+)
+
+func TestScalarMultCTMatchesBigInt(t *testing.T) {
+	point := BasePoint()
+	for i := 0; i < 8; i++ {
+		h := sha512.Sum512([]byte{byte(i)})
+		scalar, err := ReduceScalar(h[:])
+		if err != nil {
+			t.Fatalf("ReduceScalar() error = %v", err)
+		}
+
+		base, err := ScalarBaseMultCT(scalar)
+		if err != nil {
+			t.Fatalf("ScalarBaseMultCT() error = %v", err)
+		}
+		if want := ScalarBaseMult(scalar).Bytes(); !bytes.Equal(base, want) {
+			t.Errorf("ScalarBaseMultCT(%x) = %x, want %x", scalar, base, want)
+		}
+
+		got, err := ScalarMultCT(scalar, point.Bytes())
+		if err != nil {
+			t.Fatalf("ScalarMultCT() error = %v", err)
+		}
+		if want := ScalarMult(point, leToInt(scalar)).Bytes(); !bytes.Equal(got, want) {
+			t.Errorf("ScalarMultCT(%x) = %x, want %x", scalar, got, want)
+		}
+
+		sum, err := AddEncodedPoints(point.Bytes(), base)
+		if err != nil {
+			t.Fatalf("AddEncodedPoints() error = %v", err)
+		}
+		if want := AddPoints(point, ScalarBaseMult(scalar)).Bytes(); !bytes.Equal(sum, want) {
+			t.Errorf("AddEncodedPoints() = %x, want %x", sum, want)
+		}
+
+		point = AddPoints(point, point)
+	}
+}
+
+func TestReduceScalar(t *testing.T) {
+	l := intToLE32(L)
+	if got, _ := ReduceScalar(l); !bytes.Equal(got, make([]byte, ScalarSize)) {
+		t.Errorf("ReduceScalar(L) = %x, want 0", got)
+	}
+	if _, err := ReduceScalar(make([]byte, 65)); !errors.Is(err, ErrInvalidScalar) {
+		t.Errorf("ReduceScalar(65 bytes) error = %v, want %v", err, ErrInvalidScalar)
+	}
+
+	// Only reduced scalars are accepted for multiplication
+	if _, err := ScalarBaseMultCT(l); !errors.Is(err, ErrInvalidScalar) {
+		t.Errorf("ScalarBaseMultCT(L) error = %v, want %v", err, ErrInvalidScalar)
+	}
+	if _, err := ScalarMultCT(make([]byte, 31), BasePoint().Bytes()); !errors.Is(err, ErrInvalidScalar) {
+		t.Errorf("ScalarMultCT(31 bytes) error = %v, want %v", err, ErrInvalidScalar)
+	}
+}
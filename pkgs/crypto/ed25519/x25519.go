@@ -0,0 +1,94 @@
+package ed25519
+
+import (
+	"crypto/sha512"
+	"errors"
+	"math/big"
+
+	"golang.org/x/crypto/curve25519"
+)
+
+// X25519Size is the size of X25519 scalars, u-coordinates and shared secrets
+const X25519Size = 32
+
+var (
+	// ErrInvalidX25519Input is returned for scalars or u-coordinates of the wrong size
+	ErrInvalidX25519Input = errors.New("x25519: input must be 32 bytes")
+
+	// ErrLowOrderPoint is returned when the shared secret is all zeros
+	ErrLowOrderPoint = errors.New("x25519: low-order point")
+)
+
+// X25519 computes the RFC 7748 X25519 function: scalar * point on Curve25519.
+// Both inputs and the output are little-endian. The computation is
+// constant-time, by golang.org/x/crypto/curve25519.
+func X25519(scalar, point []byte) ([]byte, error) {
+	if len(scalar) != X25519Size || len(point) != X25519Size {
+		return nil, ErrInvalidX25519Input
+	}
+
+	// With correctly sized inputs, the only failure is an all-zero output
+	out, err := curve25519.X25519(scalar, point)
+	if err != nil {
+		return nil, ErrLowOrderPoint
+	}
+	return out, nil
+}
+
+// X25519PublicKey returns scalar * base point, the X25519 public key for a scalar
+func X25519PublicKey(scalar []byte) ([]byte, error) {
+	return X25519(scalar, curve25519.Basepoint)
+}
+
+// Ed25519PublicToX25519 converts an Ed25519 public key to its Curve25519
+// u-coordinate using the birational map u = (1 + y) / (1 - y).
+func Ed25519PublicToX25519(publicKey []byte) ([]byte, error) {
+	if len(publicKey) != PublicKeySize {
+		return nil, ErrInvalidPublicKey
+	}
+
+	p, err := DecodePoint(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	num := new(big.Int).Add(big.NewInt(1), p.Y)
+	den := new(big.Int).Sub(big.NewInt(1), p.Y)
+	den.Mod(den, P)
+	if den.Sign() == 0 {
+		// y = 1 is the identity, which has no Montgomery counterpart
+		return nil, ErrInvalidPoint
+	}
+
+	u := new(big.Int).Mul(num, new(big.Int).ModInverse(den, P))
+	u.Mod(u, P)
+
+	return intToLE32(u), nil
+}
+
+// Ed25519PrivateToX25519 converts a 32-byte Ed25519 private key (seed) to the
+// X25519 scalar that pairs with Ed25519PublicToX25519 of its public key.
+func Ed25519PrivateToX25519(privateKey []byte) ([]byte, error) {
+	if len(privateKey) != PrivateKeySize {
+		return nil, ErrInvalidPrivateKey
+	}
+
+	h := sha512.Sum512(privateKey)
+	scalar := make([]byte, X25519Size)
+	copy(scalar, h[:32])
+	scalar[0] &= 248
+	scalar[31] &= 127
+	scalar[31] |= 64
+
+	return scalar, nil
+}
+
+// intToLE32 encodes x as 32 little-endian bytes
+func intToLE32(x *big.Int) []byte {
+	out := make([]byte, 32)
+	be := x.Bytes()
+	for i, b := range be {
+		out[len(be)-1-i] = b
+	}
+	return out
+}
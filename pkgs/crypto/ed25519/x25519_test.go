@@ -0,0 +1,82 @@
+package ed25519
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestX25519RFC7748(t *testing.T) {
+	// RFC 7748 section 5.2 test vectors
+	tests := []struct {
+		scalar string
+		u      string
+		want   string
+	}{
+		{
+			"a546e36bf0527c9d3b16154b82465edd62144c0ac1fc5a18506a2244ba449ac4",
+			"e6db6867583030db3594c1a424b15f7c726624ec26b3353b10a903a6d0ab1c4c",
+			"c3da55379de9c6908e94ea4df28d084f32eccf03491c71f754b4075577a28552",
+		},
+		{
+			"4b66e9d4d1b4673c5ad22691957d6af5c11b6421e0ea01d42ca4169e7918ba0d",
+			"e5210f12786811d3f4b7959d0538ae2c31dbe7106fc03c3efc4cd549c715a493",
+			"95cbde9476e8907d7aade45cb4b873f88b595a68799fa152e6f8f7647aac7957",
+		},
+	}
+
+	for _, tt := range tests {
+		scalar, _ := hex.DecodeString(tt.scalar)
+		u, _ := hex.DecodeString(tt.u)
+
+		got, err := X25519(scalar, u)
+		if err != nil {
+			t.Fatalf("X25519() error = %v", err)
+		}
+		if hex.EncodeToString(got) != tt.want {
+			t.Errorf("X25519() = %x, want %s", got, tt.want)
+		}
+	}
+}
+
+func TestX25519DiffieHellman(t *testing.T) {
+	// RFC 7748 section 6.1
+	alicePriv, _ := hex.DecodeString("77076d0a7318a57d3c16c17251b26645df4c2f87ebc0992ab177fba51db92c2a")
+	bobPriv, _ := hex.DecodeString("5dab087e624a8a4b79e17f8b83800ee66f3bb1292618b6fd1c2f8b27ff88e0eb")
+
+	alicePub, _ := X25519PublicKey(alicePriv)
+	if hex.EncodeToString(alicePub) != "8520f0098930a754748b7ddcb43ef75a0dbf3a0d26381af4eba4a98eaa9b4e6a" {
+		t.Errorf("Alice public = %x", alicePub)
+	}
+
+	bobPub, _ := X25519PublicKey(bobPriv)
+	if hex.EncodeToString(bobPub) != "de9edb7d7b7dc1b4d35b61c2ece435373f8343c85b78674dadfc7e146f882b4f" {
+		t.Errorf("Bob public = %x", bobPub)
+	}
+
+	k1, _ := X25519(alicePriv, bobPub)
+	k2, _ := X25519(bobPriv, alicePub)
+	if !bytes.Equal(k1, k2) || hex.EncodeToString(k1) != "4a5d9d5ba4ce2de1728e3bf480350f25e07e21c947d19e3376f09b3c1e161742" {
+		t.Errorf("shared secret = %x / %x", k1, k2)
+	}
+
+	if _, err := X25519(alicePriv, make([]byte, 32)); err != ErrLowOrderPoint {
+		t.Errorf("X25519(zero point) error = %v, want ErrLowOrderPoint", err)
+	}
+}
+
+func TestEd25519ToX25519(t *testing.T) {
+	seed, _ := hex.DecodeString("9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60")
+
+	pub, _ := PrivateKeyToPublicKey(seed)
+	xPub, err := Ed25519PublicToX25519(pub)
+	if err != nil {
+		t.Fatalf("Ed25519PublicToX25519() error = %v", err)
+	}
+
+	xPriv, _ := Ed25519PrivateToX25519(seed)
+	want, _ := X25519PublicKey(xPriv)
+	if !bytes.Equal(xPub, want) {
+		t.Errorf("Ed25519PublicToX25519() = %x, want %x", xPub, want)
+	}
+}
@@ -0,0 +1,59 @@
+package encoding
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidDigit is returned when a digit is out of range for its base
+var ErrInvalidDigit = errors.New("invalid digit")
+
+// ConvertBase converts a big-endian number from fromBase to toBase, one digit
+// per byte. Every leading zero digit of data becomes one leading zero digit of
+// the result, so payloads starting with zero bytes round-trip; this is the rule
+// of Base58 and Stacks c32. If alphabet is non-empty the result digits are
+// mapped to its characters, otherwise they are returned as raw values.
+// Bases run from 2 to 256.
+func ConvertBase(data []byte, fromBase, toBase int, alphabet string) ([]byte, error) {
+	if fromBase < 2 || fromBase > 256 || toBase < 2 || toBase > 256 {
+		return nil, fmt.Errorf("unsupported base conversion %d to %d", fromBase, toBase)
+	}
+	if alphabet != "" && len(alphabet) != toBase {
+		return nil, fmt.Errorf("alphabet has %d characters, want %d", len(alphabet), toBase)
+	}
+
+	zeros := 0
+	for zeros < len(data) && data[zeros] == 0 {
+		zeros++
+	}
+
+	// Fold each input digit into the output digits, kept little-endian
+	var digits []byte
+	for _, d := range data[zeros:] {
+		if int(d) >= fromBase {
+			return nil, fmt.Errorf("%w: %d in base %d", ErrInvalidDigit, d, fromBase)
+		}
+		carry := int(d)
+		for i := range digits {
+			carry += int(digits[i]) * fromBase
+			digits[i] = byte(carry % toBase)
+			carry /= toBase
+		}
+		for carry > 0 {
+			digits = append(digits, byte(carry%toBase))
+			carry /= toBase
+		}
+	}
+
+	out := make([]byte, zeros+len(digits))
+	for i, d := range digits {
+		out[len(out)-1-i] = d
+	}
+	if alphabet != "" {
+		for i := range out {
+			out[i] = alphabet[out[i]]
+		}
+	}
+
+	return out, nil
+}
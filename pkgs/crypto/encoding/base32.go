@@ -0,0 +1,96 @@
+package encoding
+
+import (
+	"errors"
+	"strings"
+)
+
+// Base32 alphabets
+const (
+	// Base32StdAlphabet is the RFC 4648 alphabet (Stellar, Algorand)
+	Base32StdAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567"
+	// Base32LowerAlphabet is the lowercase RFC 4648 alphabet (ICP, Filecoin)
+	Base32LowerAlphabet = "abcdefghijklmnopqrstuvwxyz234567"
+)
+
+var ErrInvalidBase32 = errors.New("invalid base32 string")
+
+// Base32Encode encodes bytes to a Base32 string using the given 32-character
+// alphabet. If pad is true the output is padded with '=' to a multiple of 8.
+func Base32Encode(input []byte, alphabet string, pad bool) string {
+	if len(input) == 0 {
+		return ""
+	}
+
+	result := make([]byte, 0, (len(input)*8+4)/5+6)
+	var carry uint32
+	var bits uint
+
+	for _, b := range input {
+		carry = (carry << 8) | uint32(b)
+		bits += 8
+
+		for bits >= 5 {
+			bits -= 5
+			result = append(result, alphabet[(carry>>bits)&0x1F])
+		}
+	}
+
+	// Flush the remaining bits, zero-filled on the right
+	if bits > 0 {
+		result = append(result, alphabet[(carry<<(5-bits))&0x1F])
+	}
+
+	if pad {
+		for len(result)%8 != 0 {
+			result = append(result, '=')
+		}
+	}
+
+	return string(result)
+}
+
+// Base32Decode decodes a Base32 string using the given 32-character alphabet.
+// Trailing '=' padding is optional, but when present it must complete the
+// final 8-character block. Unused trailing bits must be zero.
+func Base32Decode(input string, alphabet string) ([]byte, error) {
+	if len(input) == 0 {
+		return []byte{}, nil
+	}
+
+	data := strings.TrimRight(input, "=")
+	if len(data) != len(input) && len(input)%8 != 0 {
+		return nil, ErrInvalidBase32
+	}
+
+	// A final block of 1, 3 or 6 characters can't come from whole bytes
+	switch len(data) % 8 {
+	case 1, 3, 6:
+		return nil, ErrInvalidBase32
+	}
+
+	result := make([]byte, 0, len(data)*5/8)
+	var carry uint32
+	var bits uint
+
+	for i := 0; i < len(data); i++ {
+		val := strings.IndexByte(alphabet, data[i])
+		if val < 0 {
+			return nil, ErrInvalidBase32
+		}
+
+		carry = (carry << 5) | uint32(val)
+		bits += 5
+
+		if bits >= 8 {
+			bits -= 8
+			result = append(result, byte(carry>>bits))
+		}
+	}
+
+	if carry&(1<<bits-1) != 0 {
+		return nil, ErrInvalidBase32
+	}
+
+	return result, nil
+}
@@ -0,0 +1,119 @@
+package encoding
+
+import (
+	"bytes"
+	"encoding/base32"
+	"encoding/hex"
+	"testing"
+)
+
+func TestBase32EncodeMatchesStdlib(t *testing.T) {
+	inputs := []string{"", "f", "fo", "foo", "foob", "fooba", "foobar"}
+	lower := base32.NewEncoding(Base32LowerAlphabet)
+
+	for _, in := range inputs {
+		cases := []struct {
+			alphabet string
+			pad      bool
+			want     string
+		}{
+			{Base32StdAlphabet, true, base32.StdEncoding.EncodeToString([]byte(in))},
+			{Base32StdAlphabet, false, base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte(in))},
+			{Base32LowerAlphabet, false, lower.WithPadding(base32.NoPadding).EncodeToString([]byte(in))},
+		}
+
+		for _, tc := range cases {
+			got := Base32Encode([]byte(in), tc.alphabet, tc.pad)
+			if got != tc.want {
+				t.Errorf("Base32Encode(%q, pad=%v) = %s, want %s", in, tc.pad, got, tc.want)
+			}
+
+			decoded, err := Base32Decode(got, tc.alphabet)
+			if err != nil {
+				t.Fatalf("Base32Decode(%q) error = %v", got, err)
+			}
+			if !bytes.Equal(decoded, []byte(in)) {
+				t.Errorf("Base32Decode(%q) = %q, want %q", got, decoded, in)
+			}
+		}
+	}
+}
+
+func TestBase32ChainAlphabets(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		alphabet string
+		length   int
+		first    byte
+		last     byte
+	}{
+		// Stellar account ID: version byte 6<<3, key, CRC16
+		{"stellar", "GDRXE2BQUC3AZNPVFSCEZ76NJ3WWL25FYFK6RGZGIEKWE4SOOHSUJUJ6", Base32StdAlphabet, 35, 0x30, 0},
+		// Algorand: key, 4-byte checksum
+		{"algorand", "7ZUECA7HFLZTXENRV24SHLU4AVPUTMTTDUFUBNBD64C73F3UHRTHAIOF6Q", Base32StdAlphabet, 36, 0xfe, 0},
+		// ICP anonymous principal: CRC32, 0x04
+		{"icp", "2vxsxfae", Base32LowerAlphabet, 5, 0, 0x04},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded, err := Base32Decode(tt.input, tt.alphabet)
+			if err != nil {
+				t.Fatalf("Base32Decode() error = %v", err)
+			}
+			if len(decoded) != tt.length {
+				t.Fatalf("Base32Decode() length = %d, want %d", len(decoded), tt.length)
+			}
+			if tt.first != 0 && decoded[0] != tt.first {
+				t.Errorf("Base32Decode()[0] = %#x, want %#x", decoded[0], tt.first)
+			}
+			if tt.last != 0 && decoded[len(decoded)-1] != tt.last {
+				t.Errorf("Base32Decode()[last] = %#x, want %#x", decoded[len(decoded)-1], tt.last)
+			}
+			if got := Base32Encode(decoded, tt.alphabet, false); got != tt.input {
+				t.Errorf("Base32Encode() = %s, want %s", got, tt.input)
+			}
+		})
+	}
+}
+
+func TestBase32DecodeErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"invalid character", "MZXW6YQ1"},
+		{"wrong case", "mzxw6yq"},
+		{"impossible length", "MZXW6Y"},
+		{"partial padding", "MZXW6="},
+		{"non-zero trailing bits", "MZXW6YR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Base32Decode(tt.input, Base32StdAlphabet); err != ErrInvalidBase32 {
+				t.Errorf("Base32Decode(%q) error = %v, want %v", tt.input, err, ErrInvalidBase32)
+			}
+		})
+	}
+
+}
+
+func TestBase32FilecoinPayload(t *testing.T) {
+	// Filecoin f1 payload: 20-byte hash plus 4-byte checksum
+	payload, _ := hex.DecodeString("fd1d0f4dfcd7e99afcb99a8326b7dc459d32c6280c5cc6c0")
+
+	encoded := Base32Encode(payload, Base32LowerAlphabet, false)
+	if len(encoded) != 39 {
+		t.Errorf("Base32Encode() length = %d, want 39", len(encoded))
+	}
+
+	decoded, err := Base32Decode(encoded, Base32LowerAlphabet)
+	if err != nil {
+		t.Fatalf("Base32Decode() error = %v", err)
+	}
+	if !bytes.Equal(decoded, payload) {
+		t.Errorf("Base32Decode() = %x, want %x", decoded, payload)
+	}
+}
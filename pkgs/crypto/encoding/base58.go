@@ -3,104 +3,154 @@ package encoding
 
 import (
 	"errors"
-	"math/big"
+	"strings"
 
 	"github.com/study/crypto-accounts/pkgs/crypto/hash"
 )
 
-// Base58 alphabet used by Bitcoin (excludes 0, O, I, l to avoid confusion)
-const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+// Base58 alphabets
+const (
+	// BitcoinAlphabet is the standard alphabet (excludes 0, O, I, l to avoid confusion)
+	BitcoinAlphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+	// RippleAlphabet is used by Ripple (XRP)
+	RippleAlphabet = "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz"
+
+	// FlickrAlphabet swaps the case order of the standard alphabet
+	FlickrAlphabet = "123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ"
+)
+
+const base58Alphabet = BitcoinAlphabet
 
 var (
 	ErrInvalidBase58     = errors.New("invalid base58 string")
 	ErrInvalidChecksum   = errors.New("invalid checksum")
 	ErrInvalidDataLength = errors.New("invalid data length")
+	ErrInvalidAlphabet   = errors.New("base58 alphabet must be 58 unique characters")
 )
 
-// alphabetMap maps characters to their base58 values
-var alphabetMap = func() map[byte]int64 {
-	m := make(map[byte]int64)
-	for i, c := range base58Alphabet {
-		m[byte(c)] = int64(i)
+// ValidateBase58Alphabet checks that an alphabet has exactly 58 distinct characters
+func ValidateBase58Alphabet(alphabet string) error {
+	if len(alphabet) != 58 {
+		return ErrInvalidAlphabet
 	}
-	return m
-}()
 
-// Base58Encode encodes bytes to a Base58 string.
-func Base58Encode(input []byte) string {
-	if len(input) == 0 {
-		return ""
+	var seen [256]bool
+	for i := 0; i < len(alphabet); i++ {
+		if seen[alphabet[i]] {
+			return ErrInvalidAlphabet
+		}
+		seen[alphabet[i]] = true
 	}
+	return nil
+}
 
-	// Count leading zeros
-	leadingZeros := countLeadingZeros(input)
+// Base58Encode encodes bytes to a Base58 string.
+func Base58Encode(input []byte) string {
+	encoded, _ := Base58EncodeWithAlphabet(input, base58Alphabet)
+	return encoded
+}
 
-	// Convert to big integer
-	num := new(big.Int).SetBytes(input)
-	base := big.NewInt(58)
-	zero := big.NewInt(0)
-	mod := new(big.Int)
+// Base58Decode decodes a Base58 string to bytes.
+func Base58Decode(input string) ([]byte, error) {
+	return Base58DecodeWithAlphabet(input, base58Alphabet)
+}
 
-	// Convert to base58
-	var result []byte
-	for num.Cmp(zero) > 0 {
-		num.DivMod(num, base, mod)
-		result = append(result, base58Alphabet[mod.Int64()])
+// Base58EncodeWithAlphabet encodes bytes to a Base58 string using the given
+// 58-character alphabet. Leading zero bytes map to the alphabet's first character.
+func Base58EncodeWithAlphabet(input []byte, alphabet string) (string, error) {
+	if err := ValidateBase58Alphabet(alphabet); err != nil {
+		return "", err
+	}
+	if len(input) == 0 {
+		return "", nil
 	}
 
-	// Add leading '1's for each leading zero byte
-	for i := 0; i < leadingZeros; i++ {
-		result = append(result, '1')
+	zeros := countLeadingZeros(input)
+
+	// log(256) / log(58) < 1.38, so this many digits always suffice
+	size := (len(input)-zeros)*138/100 + 1
+	digits := make([]byte, zeros+size)
+
+	// Long division: fold each byte into the base-58 digits, stored
+	// big-endian at the end of the buffer. length is the digits in use.
+	length := 0
+	for _, b := range input[zeros:] {
+		carry := int(b)
+		i := 0
+		for j := len(digits) - 1; (carry != 0 || i < length) && j >= zeros; j, i = j-1, i+1 {
+			carry += int(digits[j]) << 8
+			digits[j] = byte(carry % 58)
+			carry /= 58
+		}
+		length = i
 	}
 
-	// Reverse the result
-	reverse(result)
+	// Drop unused digits, then map digits and leading zeros to the alphabet
+	start := len(digits) - length
+	for start < len(digits) && digits[start] == 0 {
+		start++
+	}
+	out := digits[start-zeros:]
+	for i := range out {
+		out[i] = alphabet[out[i]]
+	}
 
-	return string(result)
+	return string(out), nil
 }
 
-// Base58Decode decodes a Base58 string to bytes.
-func Base58Decode(input string) ([]byte, error) {
+// Base58DecodeWithAlphabet decodes a Base58 string using the given 58-character alphabet.
+func Base58DecodeWithAlphabet(input string, alphabet string) ([]byte, error) {
+	if err := ValidateBase58Alphabet(alphabet); err != nil {
+		return nil, err
+	}
 	if len(input) == 0 {
 		return nil, nil
 	}
 
-	// Count leading '1's
-	leadingOnes := 0
-	for _, c := range input {
-		if c != '1' {
-			break
-		}
-		leadingOnes++
+	// Count leading zero characters
+	zeros := 0
+	for zeros < len(input) && input[zeros] == alphabet[0] {
+		zeros++
 	}
 
-	// Convert from base58 to big integer
-	num := big.NewInt(0)
-	base := big.NewInt(58)
+	// log(58) / log(256) < 0.733, so this many bytes always suffice
+	size := (len(input)-zeros)*733/1000 + 1
+	result := make([]byte, zeros+size)
 
-	for _, c := range input {
-		val, ok := alphabetMap[byte(c)]
-		if !ok {
+	// Multiply-and-add each digit into the big-endian bytes at the end of result
+	length := 0
+	for _, c := range []byte(input[zeros:]) {
+		carry := strings.IndexByte(alphabet, c)
+		if carry < 0 {
 			return nil, ErrInvalidBase58
 		}
-		num.Mul(num, base)
-		num.Add(num, big.NewInt(val))
-	}
 
-	// Convert to bytes
-	decoded := num.Bytes()
+		i := 0
+		for j := len(result) - 1; (carry != 0 || i < length) && j >= zeros; j, i = j-1, i+1 {
+			carry += int(result[j]) * 58
+			result[j] = byte(carry)
+			carry >>= 8
+		}
+		length = i
+	}
 
-	// Add leading zeros
-	result := make([]byte, leadingOnes+len(decoded))
-	copy(result[leadingOnes:], decoded)
+	// Move the value down so it directly follows the leading zero bytes
+	start := len(result) - length
+	for start < len(result) && result[start] == 0 {
+		start++
+	}
+	n := copy(result[zeros:], result[start:])
 
-	return result, nil
+	return result[:zeros+n], nil
 }
 
 // Base58CheckEncode encodes bytes with a 4-byte checksum appended.
 func Base58CheckEncode(input []byte) string {
-	checksum := hash.Checksum(input)
-	return Base58Encode(append(input, checksum...))
+	buf := make([]byte, 0, len(input)+4)
+	buf = append(buf, input...)
+	buf = append(buf, hash.Checksum(input)...)
+	return Base58Encode(buf)
 }
 
 // Base58CheckDecode decodes a Base58Check string and verifies the checksum.
@@ -135,9 +185,3 @@ func countLeadingZeros(data []byte) int {
 	}
 	return count
 }
-
-func reverse(data []byte) {
-	for i, j := 0, len(data)-1; i < j; i, j = i+1, j-1 {
-		data[i], data[j] = data[j], data[i]
-	}
-}
@@ -2,7 +2,9 @@ package encoding
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/hex"
+	"math/big"
 	"testing"
 )
 
@@ -351,3 +353,113 @@ func TestLeadingZeroPreservation(t *testing.T) {
 		}
 	}
 }
+
+func TestBase58WithRippleAlphabet(t *testing.T) {
+	// Genesis account: rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh
+	accountID, _ := hex.DecodeString("b5f762798a53d543a014caf8b297cff8f2f937e8")
+	payload := append([]byte{0x00}, accountID...)
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	payload = append(payload, second[:4]...)
+
+	encoded, err := Base58EncodeWithAlphabet(payload, RippleAlphabet)
+	if err != nil {
+		t.Fatalf("Base58EncodeWithAlphabet() error = %v", err)
+	}
+	if encoded != "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh" {
+		t.Errorf("Base58EncodeWithAlphabet() = %s, want rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", encoded)
+	}
+
+	decoded, err := Base58DecodeWithAlphabet(encoded, RippleAlphabet)
+	if err != nil {
+		t.Fatalf("Base58DecodeWithAlphabet() error = %v", err)
+	}
+	if !bytes.Equal(decoded, payload) {
+		t.Errorf("Base58DecodeWithAlphabet() = %x, want %x", decoded, payload)
+	}
+
+	// '0' is not part of the Ripple alphabet
+	if _, err := Base58DecodeWithAlphabet("r0", RippleAlphabet); err != ErrInvalidBase58 {
+		t.Errorf("Base58DecodeWithAlphabet() error = %v, want %v", err, ErrInvalidBase58)
+	}
+}
+
+func TestBase58InvalidAlphabet(t *testing.T) {
+	alphabets := []string{
+		"",
+		BitcoinAlphabet[:57],       // too short
+		BitcoinAlphabet + "0",      // too long
+		"1" + BitcoinAlphabet[:57], // repeats '1'
+	}
+	for _, alphabet := range alphabets {
+		if _, err := Base58EncodeWithAlphabet([]byte{1, 2, 3}, alphabet); err != ErrInvalidAlphabet {
+			t.Errorf("Base58EncodeWithAlphabet(%q) error = %v, want %v", alphabet, err, ErrInvalidAlphabet)
+		}
+		if _, err := Base58DecodeWithAlphabet("2", alphabet); err != ErrInvalidAlphabet {
+			t.Errorf("Base58DecodeWithAlphabet(%q) error = %v, want %v", alphabet, err, ErrInvalidAlphabet)
+		}
+	}
+
+	for _, alphabet := range []string{BitcoinAlphabet, RippleAlphabet, FlickrAlphabet} {
+		if err := ValidateBase58Alphabet(alphabet); err != nil {
+			t.Errorf("ValidateBase58Alphabet(%s) error = %v", alphabet, err)
+		}
+	}
+}
+
+// TestBase58MatchesBigInt checks the long-division encoder against a
+// straightforward math/big conversion on inputs of every length up to 64 bytes.
+func TestBase58MatchesBigInt(t *testing.T) {
+	reference := func(input []byte) string {
+		var out []byte
+		for num := new(big.Int).SetBytes(input); num.Sign() > 0; {
+			mod := new(big.Int)
+			num.DivMod(num, big.NewInt(58), mod)
+			out = append([]byte{base58Alphabet[mod.Int64()]}, out...)
+		}
+		return string(bytes.Repeat([]byte{'1'}, countLeadingZeros(input))) + string(out)
+	}
+
+	for n := 1; n <= 64; n++ {
+		input := sha256.Sum256([]byte{byte(n)})
+		data := append(make([]byte, n%3), bytes.Repeat(input[:], 2)[:n]...)
+
+		encoded := Base58Encode(data)
+		if want := reference(data); encoded != want {
+			t.Errorf("Base58Encode(%x) = %s, want %s", data, encoded, want)
+		}
+		decoded, err := Base58Decode(encoded)
+		if err != nil || !bytes.Equal(decoded, data) {
+			t.Errorf("Base58Decode(%s) = %x, %v, want %x", encoded, decoded, err, data)
+		}
+	}
+}
+
+// benchmarkPayload is a P2PKH address payload: version, HASH160 and checksum
+var benchmarkPayload, _ = hex.DecodeString("00751e76e8199196d454941c45d1b3a323f1433bd6510d7b9c")
+
+func BenchmarkBase58Encode(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Base58Encode(benchmarkPayload)
+	}
+}
+
+func BenchmarkBase58Decode(b *testing.B) {
+	encoded := Base58Encode(benchmarkPayload)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Base58Decode(encoded); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBase58CheckEncode(b *testing.B) {
+	payload := benchmarkPayload[:21]
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Base58CheckEncode(payload)
+	}
+}
@@ -0,0 +1,58 @@
+package encoding
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestConvertBase(t *testing.T) {
+	inputs := [][]byte{
+		{},
+		{0},
+		{0, 0, 0},
+		{0, 0, 1, 2, 3},
+		{0xff, 0xff},
+		[]byte("hello world"),
+	}
+
+	for _, data := range inputs {
+		// Base 58 with the Bitcoin alphabet is Base58Encode
+		encoded, err := ConvertBase(data, 256, 58, base58Alphabet)
+		if err != nil {
+			t.Fatalf("ConvertBase(%x) error = %v", data, err)
+		}
+		if string(encoded) != Base58Encode(data) {
+			t.Errorf("ConvertBase(%x, 256, 58) = %s, want %s", data, encoded, Base58Encode(data))
+		}
+
+		// Round trip through raw base 32 digits
+		digits, err := ConvertBase(data, 256, 32, "")
+		if err != nil {
+			t.Fatalf("ConvertBase(%x) error = %v", data, err)
+		}
+		back, err := ConvertBase(digits, 32, 256, "")
+		if err != nil {
+			t.Fatalf("ConvertBase(%v) error = %v", digits, err)
+		}
+		if !bytes.Equal(back, data) {
+			t.Errorf("ConvertBase round trip = %x, want %x", back, data)
+		}
+	}
+
+	if got, _ := ConvertBase([]byte{1, 0}, 256, 16, "0123456789abcdef"); string(got) != "100" {
+		t.Errorf("ConvertBase(0100, 256, 16) = %s, want 100", got)
+	}
+}
+
+func TestConvertBaseInvalid(t *testing.T) {
+	if _, err := ConvertBase([]byte{32}, 32, 256, ""); !errors.Is(err, ErrInvalidDigit) {
+		t.Errorf("ConvertBase() with digit 32 in base 32 error = %v, want ErrInvalidDigit", err)
+	}
+	if _, err := ConvertBase([]byte{1}, 256, 1, ""); err == nil {
+		t.Error("ConvertBase() to base 1 should fail")
+	}
+	if _, err := ConvertBase([]byte{1}, 256, 16, "0123"); err == nil {
+		t.Error("ConvertBase() with a short alphabet should fail")
+	}
+}
@@ -0,0 +1,32 @@
+package encoding
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+var ErrInvalidVarint = errors.New("invalid varint")
+
+// UvarintLE encodes x as an unsigned LEB128 varint: 7 bits per byte, least
+// significant group first, with the high bit set on every byte but the last.
+// Filecoin encodes the actor ID of f0 addresses this way.
+func UvarintLE(x uint64) []byte {
+	return binary.AppendUvarint(nil, x)
+}
+
+// DecodeUvarintLE decodes an unsigned LEB128 varint from the start of data,
+// returning the value and the number of bytes read. Truncated input, values
+// overflowing 64 bits and non-minimal encodings are rejected.
+func DecodeUvarintLE(data []byte) (uint64, int, error) {
+	x, n := binary.Uvarint(data)
+	if n <= 0 {
+		return 0, 0, ErrInvalidVarint
+	}
+
+	// A trailing zero group means the value fits in fewer bytes
+	if n > 1 && data[n-1] == 0 {
+		return 0, 0, ErrInvalidVarint
+	}
+
+	return x, n, nil
+}
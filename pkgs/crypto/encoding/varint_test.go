@@ -0,0 +1,50 @@
+package encoding
+
+import (
+	"encoding/hex"
+	"math"
+	"testing"
+)
+
+func TestUvarintLE(t *testing.T) {
+	tests := []struct {
+		value uint64
+		hex   string
+	}{
+		{0, "00"},
+		{127, "7f"},
+		{128, "8001"},
+		{300, "ac02"},
+		{624485, "e58e26"},
+		{math.MaxUint64, "ffffffffffffffffff01"},
+	}
+
+	for _, tt := range tests {
+		encoded := UvarintLE(tt.value)
+		if hex.EncodeToString(encoded) != tt.hex {
+			t.Errorf("UvarintLE(%d) = %x, want %s", tt.value, encoded, tt.hex)
+		}
+
+		decoded, n, err := DecodeUvarintLE(encoded)
+		if err != nil {
+			t.Fatalf("DecodeUvarintLE(%s) error = %v", tt.hex, err)
+		}
+		if decoded != tt.value || n != len(encoded) {
+			t.Errorf("DecodeUvarintLE(%s) = %d, %d, want %d, %d", tt.hex, decoded, n, tt.value, len(encoded))
+		}
+	}
+}
+
+func TestDecodeUvarintLEInvalid(t *testing.T) {
+	for _, input := range []string{
+		"",                     // empty
+		"80",                   // truncated
+		"8000",                 // non-minimal zero
+		"ffffffffffffffffff02", // overflows 64 bits
+	} {
+		data, _ := hex.DecodeString(input)
+		if _, _, err := DecodeUvarintLE(data); err != ErrInvalidVarint {
+			t.Errorf("DecodeUvarintLE(%s) error = %v, want ErrInvalidVarint", input, err)
+		}
+	}
+}
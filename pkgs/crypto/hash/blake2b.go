@@ -0,0 +1,108 @@
+package hash
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// BLAKE2b as specified in RFC 7693, with the personalization parameter that
+// golang.org/x/crypto/blake2b does not expose. Nervos CKB hashes with it.
+
+const (
+	blake2bBlockSize = 128
+	blake2bRounds    = 12
+)
+
+// blake2bIV is the initial chain value, shared with SHA-512
+var blake2bIV = [8]uint64{
+	0x6a09e667f3bcc908, 0xbb67ae8584caa73b, 0x3c6ef372fe94f82b, 0xa54ff53a5f1d36f1,
+	0x510e527fade682d1, 0x9b05688c2b3e6c1f, 0x1f83d9abfb41bd6b, 0x5be0cd19137e2179,
+}
+
+// Blake2b256Personal computes unkeyed BLAKE2b-256 with a personalization of
+// up to 16 bytes, zero-padded. It panics if personal is longer.
+func Blake2b256Personal(data, personal []byte) []byte {
+	return blake2bPersonal(data, personal, 32)
+}
+
+// blake2bPersonal computes unkeyed BLAKE2b with a size-byte digest
+func blake2bPersonal(data, personal []byte, size int) []byte {
+	if len(personal) > 16 {
+		panic("hash: BLAKE2b personalization longer than 16 bytes")
+	}
+
+	// Parameter block: digest length, fanout 1, depth 1, personalization
+	h := blake2bIV
+	h[0] ^= 0x01010000 ^ uint64(size)
+	var p [16]byte
+	copy(p[:], personal)
+	h[6] ^= binary.LittleEndian.Uint64(p[0:8])
+	h[7] ^= binary.LittleEndian.Uint64(p[8:16])
+
+	// The last block, even if full or empty, is compressed with the final flag
+	var counter uint64
+	for len(data) > blake2bBlockSize {
+		counter += blake2bBlockSize
+		blake2bCompress(&h, data[:blake2bBlockSize], counter, false)
+		data = data[blake2bBlockSize:]
+	}
+
+	var last [blake2bBlockSize]byte
+	copy(last[:], data)
+	counter += uint64(len(data))
+	blake2bCompress(&h, last[:], counter, true)
+
+	out := make([]byte, 64)
+	for i, word := range h {
+		binary.LittleEndian.PutUint64(out[i*8:], word)
+	}
+	return out[:size]
+}
+
+// blake2bCompress is the compression function F, for messages under 2^64 bytes
+func blake2bCompress(h *[8]uint64, block []byte, counter uint64, final bool) {
+	var m [16]uint64
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint64(block[i*8:])
+	}
+
+	var v [16]uint64
+	copy(v[:8], h[:])
+	copy(v[8:], blake2bIV[:])
+	v[12] ^= counter
+	if final {
+		v[14] = ^v[14]
+	}
+
+	g := func(s *[16]uint8, i, a, b, c, d int) {
+		v[a] += v[b] + m[s[2*i]]
+		v[d] = bits.RotateLeft64(v[d]^v[a], -32)
+		v[c] += v[d]
+		v[b] = bits.RotateLeft64(v[b]^v[c], -24)
+		v[a] += v[b] + m[s[2*i+1]]
+		v[d] = bits.RotateLeft64(v[d]^v[a], -16)
+		v[c] += v[d]
+		v[b] = bits.RotateLeft64(v[b]^v[c], -63)
+	}
+
+	// BLAKE2b shares BLAKE's message permutations
+	for r := 0; r < blake2bRounds; r++ {
+		s := &blakeSigma[r%10]
+
+		// Columns
+		g(s, 0, 0, 4, 8, 12)
+		g(s, 1, 1, 5, 9, 13)
+		g(s, 2, 2, 6, 10, 14)
+		g(s, 3, 3, 7, 11, 15)
+
+		// Diagonals
+		g(s, 4, 0, 5, 10, 15)
+		g(s, 5, 1, 6, 11, 12)
+		g(s, 6, 2, 7, 8, 13)
+		g(s, 7, 3, 4, 9, 14)
+	}
+
+	for i := range h {
+		h[i] ^= v[i] ^ v[i+8]
+	}
+}
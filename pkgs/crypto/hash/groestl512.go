@@ -0,0 +1,146 @@
+package hash
+
+import "encoding/binary"
+
+// Grøstl-512, the SHA-3 finalist Groestlcoin hashes with. Its 1024-bit state
+// is an 8x16 byte matrix filled column by column; the permutations P and Q
+// reuse the AES S-box.
+
+const (
+	groestl512BlockSize = 128
+	groestl512Rounds    = 14
+	groestlColumns      = groestl512BlockSize / 8
+)
+
+type groestlState [groestl512BlockSize]byte
+
+// groestlShiftP and groestlShiftQ are the left rotations of each row
+var (
+	groestlShiftP = [8]int{0, 1, 2, 3, 4, 5, 6, 11}
+	groestlShiftQ = [8]int{1, 3, 5, 11, 0, 2, 4, 6}
+)
+
+// groestlMix is the first row of the circulant MixBytes matrix
+var groestlMix = [8]byte{2, 2, 3, 4, 5, 3, 5, 7}
+
+// aesSBox is the AES S-box: the GF(2^8) inverse followed by an affine map
+var aesSBox = func() (sbox [256]byte) {
+	for x := 0; x < 256; x++ {
+		// x^254 is the multiplicative inverse, and maps 0 to 0
+		inv, sq := byte(1), byte(x)
+		for e := 254; e > 0; e >>= 1 {
+			if e&1 == 1 {
+				inv = gfMul(inv, sq)
+			}
+			sq = gfMul(sq, sq)
+		}
+
+		s := inv
+		for i := 1; i <= 4; i++ {
+			s ^= inv<<i | inv>>(8-i)
+		}
+		sbox[x] = s ^ 0x63
+	}
+	return sbox
+}()
+
+// Groestl512 computes the Grøstl-512 hash of the input data, used by Groestlcoin.
+func Groestl512(data []byte) []byte {
+	// Pad with a 1 bit, zeros and the 64-bit count of padded blocks
+	padded := make([]byte, (len(data)+9+groestl512BlockSize-1)/groestl512BlockSize*groestl512BlockSize)
+	copy(padded, data)
+	padded[len(data)] = 0x80
+	binary.BigEndian.PutUint64(padded[len(padded)-8:], uint64(len(padded)/groestl512BlockSize))
+
+	// The initial value encodes the output size in bits
+	var h groestlState
+	binary.BigEndian.PutUint16(h[groestl512BlockSize-2:], 512)
+
+	for offset := 0; offset < len(padded); offset += groestl512BlockSize {
+		var m, p groestlState
+		copy(m[:], padded[offset:])
+		for i := range p {
+			p[i] = h[i] ^ m[i]
+		}
+
+		groestlPermute(&p, false)
+		groestlPermute(&m, true)
+		for i := range h {
+			h[i] ^= p[i] ^ m[i]
+		}
+	}
+
+	// Output transformation: truncate P(h) xor h to its last 512 bits
+	out := h
+	groestlPermute(&out, false)
+	for i := range out {
+		out[i] ^= h[i]
+	}
+	return append([]byte(nil), out[groestl512BlockSize-64:]...)
+}
+
+// groestlPermute applies P1024, or Q1024 when q is set, in place. Byte
+// col*8+row of the state is the matrix entry at that row and column.
+func groestlPermute(s *groestlState, q bool) {
+	shift := &groestlShiftP
+	if q {
+		shift = &groestlShiftQ
+	}
+
+	for r := 0; r < groestl512Rounds; r++ {
+		// AddRoundConstant
+		for col := 0; col < groestlColumns; col++ {
+			constant := byte(col<<4) ^ byte(r)
+			if q {
+				for row := 0; row < 7; row++ {
+					s[col*8+row] ^= 0xff
+				}
+				s[col*8+7] ^= 0xff ^ constant
+			} else {
+				s[col*8] ^= constant
+			}
+		}
+
+		// SubBytes
+		for i := range s {
+			s[i] = aesSBox[s[i]]
+		}
+
+		// ShiftBytes
+		var shifted groestlState
+		for row := 0; row < 8; row++ {
+			for col := 0; col < groestlColumns; col++ {
+				shifted[col*8+row] = s[(col+shift[row])%groestlColumns*8+row]
+			}
+		}
+
+		// MixBytes
+		for col := 0; col < groestlColumns; col++ {
+			column := shifted[col*8 : col*8+8]
+			for row := 0; row < 8; row++ {
+				var b byte
+				for k := 0; k < 8; k++ {
+					b ^= gfMul(groestlMix[(k-row+8)%8], column[k])
+				}
+				s[col*8+row] = b
+			}
+		}
+	}
+}
+
+// gfMul multiplies in GF(2^8) modulo the AES polynomial x^8 + x^4 + x^3 + x + 1
+func gfMul(a, b byte) byte {
+	var p byte
+	for b != 0 {
+		if b&1 == 1 {
+			p ^= a
+		}
+		carry := a & 0x80
+		a <<= 1
+		if carry != 0 {
+			a ^= 0x1b
+		}
+		b >>= 1
+	}
+	return p
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"crypto/sha512"
+	"crypto/subtle"
 
 	"golang.org/x/crypto/ripemd160"
 )
@@ -15,6 +16,14 @@ func SHA256(data []byte) []byte {
 	return h[:]
 }
 
+// SHA512_256 computes the SHA-512/256 hash of the input data, used by Algorand.
+// It is SHA-512 with distinct initial values truncated to 32 bytes, not a
+// truncation of SHA-512.
+func SHA512_256(data []byte) []byte {
+	h := sha512.Sum512_256(data)
+	return h[:]
+}
+
 // DoubleSHA256 computes SHA256(SHA256(data)), commonly used in Bitcoin.
 func DoubleSHA256(data []byte) []byte {
 	first := sha256.Sum256(data)
@@ -57,11 +66,5 @@ func VerifyChecksum(data []byte) bool {
 	checksum := data[len(data)-4:]
 	expected := Checksum(payload)
 
-	for i := 0; i < 4; i++ {
-		if checksum[i] != expected[i] {
-			return false
-		}
-	}
-
-	return true
+	return subtle.ConstantTimeCompare(checksum, expected) == 1
 }
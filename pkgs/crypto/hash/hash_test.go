@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"encoding/hex"
 	"testing"
+
+	"golang.org/x/crypto/blake2b"
 )
 
 func TestSHA256(t *testing.T) {
@@ -41,6 +43,37 @@ func TestSHA256(t *testing.T) {
 	}
 }
 
+func TestSHA512_256(t *testing.T) {
+	// NIST FIPS 180-4 example values
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "empty string",
+			input:    "",
+			expected: "c672b8d1ef56ed28ab87c3622c5114069bdd3ad7b8f9737498d0c01ecef0967a",
+		},
+		{
+			name:     "abc",
+			input:    "abc",
+			expected: "53048e2681941ef99b2e29b76b4c7dabe4c2d0c634fc6d46e0e2f13107e7af23",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := SHA512_256([]byte(tt.input))
+			expected, _ := hex.DecodeString(tt.expected)
+
+			if !bytes.Equal(result, expected) {
+				t.Errorf("SHA512_256() = %x, want %s", result, tt.expected)
+			}
+		})
+	}
+}
+
 func TestDoubleSHA256(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -255,12 +288,91 @@ func TestVerifyChecksumRoundTrip(t *testing.T) {
 	}
 }
 
+func TestBlake256(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected string
+	}{
+		{"empty", nil, "716f6e863f744b9ac22c97ec7b76ea5f5908bc5b2f67c61510bfc4751384ea7a"},
+		// The one- and two-block examples of the BLAKE specification
+		{"one zero byte", make([]byte, 1), "0ce8d4ef4dd7cd8d62dfded9d4edb0a774ae6a41929a74da23109e8f11139c87"},
+		{"72 zero bytes", make([]byte, 72), "d419bad32d504fb7d44d460c42c5593fe544fa4c135dec31e21bd9abdcc22d41"},
+		{"The quick brown fox", []byte("The quick brown fox jumps over the lazy dog"), "7576698ee9cad30173080678e5965916adbb11cb5245d386bf1ffda1cb26c9d7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Blake256(tt.input); hex.EncodeToString(result) != tt.expected {
+				t.Errorf("Blake256() = %x, want %s", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBlake2bPersonal(t *testing.T) {
+	// RFC 7693 Appendix A: BLAKE2b-512("abc")
+	const want = "ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d17d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923"
+	if got := hex.EncodeToString(blake2bPersonal([]byte("abc"), nil, 64)); got != want {
+		t.Errorf("BLAKE2b-512(abc) = %s, want %s", got, want)
+	}
+
+	// Without personalization it matches x/crypto, across block boundaries
+	for _, n := range []int{0, 1, 127, 128, 129, 256, 300} {
+		data := sequentialBytes(n)
+		want := blake2b.Sum256(data)
+		if got := Blake2b256Personal(data, nil); !bytes.Equal(got, want[:]) {
+			t.Errorf("Blake2b256Personal(%d bytes) = %x, want %x", n, got, want)
+		}
+	}
+
+	// CKB's default hash of the empty string
+	if got := hex.EncodeToString(Blake2b256Personal(nil, []byte("ckb-default-hash"))); got != "44f4c69744d5f8c55d642062949dcae49bc4e7ef43d388c5a12f42b5633d163e" {
+		t.Errorf("ckb-default-hash(empty) = %s", got)
+	}
+}
+
+func TestGroestl512(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"empty", "", "6d3ad29d279110eef3adbd66de2a0345a77baede1557f5d099fce0c03d6dc2ba8e6d4a6633dfbd66053c20faa87d1a11f39a7fbe4a6c2f009801370308fc4ad8"},
+		{"The quick brown fox", "The quick brown fox jumps over the lazy dog", "badc1f70ccd69e0cf3760c3f93884289da84ec13c70b3d12a53a7a8a4a513f99715d46288f55e1dbf926e6d084a0538e4eebfc91cf2b21452921ccde9131718d"},
+		// Bytes 0, 1, 2, ... cross-checked against an independent row/column
+		// implementation of the specification. 119 bytes leave room for the
+		// padding, 120 spill it into a second block, and longer inputs chain
+		{"119 bytes", string(sequentialBytes(119)), "b37602eb3cb6226e83ce18695d15f19f7e01afff69f4a76103afb789d073a757fc6d97242e80ee92e0953d8617174375ae5227581c1630098e3048bc5bfdfc5a"},
+		{"120 bytes", string(sequentialBytes(120)), "5cfc13a05459f11cab784846d953da0b7c3eda4855db918da20993665b7e7260cb3711782f402c04b49a03f70414246d56217e97e261cef8f0c225fd124cb971"},
+		{"128 bytes", string(sequentialBytes(128)), "70b56b15a86cd65b19f4afe78f7b408b72287947cc0d28ba4189573fbe033cf9a3298127b460778feecca5794407539acc267b27732e4fbc21bc96fcf9f2f17a"},
+		{"300 bytes", string(sequentialBytes(300)), "159204e1be4611568dc593c231cbb19a16d9b61b7ad1b4d60eba5e38ee71e533b8f8cfdd59ebd3208be0a7c885037d8ac4d58f440171bf92e2b370d2a91434f9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Groestl512([]byte(tt.input)); hex.EncodeToString(result) != tt.expected {
+				t.Errorf("Groestl512() = %x, want %s", result, tt.expected)
+			}
+		})
+	}
+}
+
 // Helper functions
 func hexToBytes(s string) []byte {
 	b, _ := hex.DecodeString(s)
 	return b
 }
 
+// sequentialBytes returns n bytes counting up from zero
+func sequentialBytes(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(i)
+	}
+	return b
+}
+
 func appendChecksum(data []byte) []byte {
 	checksum := Checksum(data)
 	return append(data, checksum...)
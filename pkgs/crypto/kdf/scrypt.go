@@ -0,0 +1,72 @@
+// Package kdf provides the password-based key derivation functions used to
+// encrypt private keys, with the parameter sets the key formats mandate.
+package kdf
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// ErrInvalidParams is returned for scrypt parameters outside their valid range
+var ErrInvalidParams = errors.New("kdf: invalid scrypt parameters")
+
+// ScryptParams are the scrypt cost parameters: N is the CPU/memory cost (a
+// power of two above 1), R the block size and P the parallelization.
+type ScryptParams struct {
+	N int
+	R int
+	P int
+}
+
+// Scrypt parameter presets, kept constant so no caller can weaken them
+const (
+	// BIP38N, BIP38R and BIP38P are fixed by BIP-38 encrypted private keys
+	BIP38N = 16384
+	BIP38R = 8
+	BIP38P = 8
+
+	// KeystoreStandardN, R and P are geth's default V3 keystore parameters (256 MB)
+	KeystoreStandardN = 1 << 18
+	KeystoreStandardR = 8
+	KeystoreStandardP = 1
+
+	// KeystoreLightN, R and P are geth's --lightkdf V3 keystore parameters (4 MB)
+	KeystoreLightN = 1 << 12
+	KeystoreLightR = 8
+	KeystoreLightP = 6
+)
+
+// BIP38 returns the parameter set fixed by BIP-38 encrypted private keys
+func BIP38() ScryptParams {
+	return ScryptParams{N: BIP38N, R: BIP38R, P: BIP38P}
+}
+
+// KeystoreStandard returns geth's default V3 keystore parameter set
+func KeystoreStandard() ScryptParams {
+	return ScryptParams{N: KeystoreStandardN, R: KeystoreStandardR, P: KeystoreStandardP}
+}
+
+// KeystoreLight returns geth's --lightkdf V3 keystore parameter set
+func KeystoreLight() ScryptParams {
+	return ScryptParams{N: KeystoreLightN, R: KeystoreLightR, P: KeystoreLightP}
+}
+
+// String formats the parameters as N/r/p
+func (p ScryptParams) String() string {
+	return fmt.Sprintf("N=%d r=%d p=%d", p.N, p.R, p.P)
+}
+
+// DeriveKey derives a keyLen-byte key from a password and salt with scrypt
+func DeriveKey(password, salt []byte, params ScryptParams, keyLen int) ([]byte, error) {
+	if params.N <= 1 || params.N&(params.N-1) != 0 || params.R <= 0 || params.P <= 0 || keyLen <= 0 {
+		return nil, fmt.Errorf("%w: %s, key length %d", ErrInvalidParams, params, keyLen)
+	}
+
+	key, err := scrypt.Key(password, salt, params.N, params.R, params.P, keyLen)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidParams, err)
+	}
+	return key, nil
+}
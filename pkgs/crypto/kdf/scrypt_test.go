@@ -0,0 +1,48 @@
+package kdf
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+// RFC 7914 section 12 test vectors
+func TestDeriveKey(t *testing.T) {
+	tests := []struct {
+		password string
+		salt     string
+		params   ScryptParams
+		want     string
+	}{
+		{
+			"", "", ScryptParams{N: 16, R: 1, P: 1},
+			"77d6576238657b203b19ca42c18a0497f16b4844e3074ae8dfdffa3fede21442fcd0069ded0948f8326a753a0fc81f17e8d3e0fb2e0d3628cf35e20c38d18906",
+		},
+		{
+			"password", "NaCl", ScryptParams{N: 1024, R: 8, P: 16},
+			"fdbabe1c9d3472007856e7190d01e9fe7c6ad7cbc8237830e77376634b3731622eaf30d92e22a3886ff109279d9830dac727afb94a83ee6d8360cbdfa2cc0640",
+		},
+		{
+			"pleaseletmein", "SodiumChloride", ScryptParams{N: 16384, R: 8, P: 1},
+			"7023bdcb3afd7348461c06cd81fd38ebfda8fbba904f8e3ea9b543f6545da1f2d5432955613f0fcf62d49705242a9af9e61e85dc0d651e40dfcf017b45575887",
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := DeriveKey([]byte(tt.password), []byte(tt.salt), tt.params, 64)
+		if err != nil {
+			t.Fatalf("DeriveKey(%q) error = %v", tt.password, err)
+		}
+		if hex.EncodeToString(got) != tt.want {
+			t.Errorf("DeriveKey(%q) = %x, want %s", tt.password, got, tt.want)
+		}
+	}
+}
+
+func TestDeriveKeyInvalidParams(t *testing.T) {
+	for _, params := range []ScryptParams{{N: 1000, R: 8, P: 1}, {N: 1, R: 8, P: 1}, {N: 16, R: 0, P: 1}} {
+		if _, err := DeriveKey([]byte("password"), nil, params, 32); !errors.Is(err, ErrInvalidParams) {
+			t.Errorf("DeriveKey(%s) error = %v, want ErrInvalidParams", params, err)
+		}
+	}
+}
@@ -3,7 +3,6 @@ package rsa
 
 import (
 	"crypto"
-	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha256"
 	"fmt"
@@ -35,7 +34,7 @@ func (p *PSS) Sign(data []byte) ([]byte, error) {
 		Hash:       crypto.SHA256,
 	}
 
-	signature, err := rsa.SignPSS(rand.Reader, p.key, crypto.SHA256, hash[:], opts)
+	signature, err := rsa.SignPSS(Rand, p.key, crypto.SHA256, hash[:], opts)
 	if err != nil {
 		return nil, fmt.Errorf("signing failed: %w", err)
 	}
@@ -8,9 +8,14 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"io"
 	"math/big"
 )
 
+// Rand is the source of randomness for key generation and PSS signing.
+// It defaults to crypto/rand; tests and hardware RNG integrations may replace it.
+var Rand io.Reader = rand.Reader
+
 // KeySize represents RSA key sizes
 type KeySize int
 
@@ -23,7 +28,7 @@ const (
 
 // GenerateKey generates a new RSA key pair
 func GenerateKey(bits KeySize) (*rsa.PrivateKey, error) {
-	return rsa.GenerateKey(rand.Reader, int(bits))
+	return rsa.GenerateKey(Rand, int(bits))
 }
 
 // GenerateArweaveKey generates a 4096-bit RSA key for Arweave
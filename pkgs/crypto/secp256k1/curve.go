@@ -60,6 +60,23 @@ func (p *Point) Equal(other *Point) bool {
 	return p.X.Cmp(other.X) == 0 && p.Y.Cmp(other.Y) == 0
 }
 
+// IsOnCurve reports whether p satisfies y^2 = x^3 + 7 with both coordinates
+// reduced modulo P. The point at infinity is not on the curve.
+func IsOnCurve(p *Point) bool {
+	if p == nil || p.X.Sign() < 0 || p.X.Cmp(P) >= 0 || p.Y.Sign() < 0 || p.Y.Cmp(P) >= 0 {
+		return false
+	}
+
+	y2 := new(big.Int).Mul(p.Y, p.Y)
+	y2.Mod(y2, P)
+
+	x3 := new(big.Int).Exp(p.X, big.NewInt(3), P)
+	x3.Add(x3, big.NewInt(7))
+	x3.Mod(x3, P)
+
+	return y2.Cmp(x3) == 0
+}
+
 // Add performs point addition: P1 + P2.
 func Add(p1, p2 *Point) *Point {
 	if p1.IsInfinity() {
@@ -98,6 +115,12 @@ func Add(p1, p2 *Point) *Point {
 	return &Point{X: x3, Y: y3}
 }
 
+// AddPoints returns a + b. It is the exported name for point addition used by
+// key tweaking and shared-key schemes.
+func AddPoints(a, b *Point) *Point {
+	return Add(a, b)
+}
+
 // Double performs point doubling: 2P.
 func Double(p *Point) *Point {
 	if p.Y.Sign() == 0 {
@@ -171,3 +194,15 @@ func AddPrivateKeys(k1, k2 []byte) []byte {
 
 	return padded
 }
+
+// PrivateKeyFromBytes reduces arbitrary input (interpreted big-endian) modulo N
+// into a 32-byte private key. It fails only if the result is zero.
+func PrivateKeyFromBytes(data []byte) ([]byte, error) {
+	k := new(big.Int).SetBytes(data)
+	k.Mod(k, N)
+	if k.Sign() == 0 {
+		return nil, ErrInvalidPrivKey
+	}
+
+	return k.FillBytes(make([]byte, 32)), nil
+}
@@ -50,6 +50,31 @@ func TestInfinity(t *testing.T) {
 	}
 }
 
+func TestIsOnCurve(t *testing.T) {
+	if !IsOnCurve(Generator()) {
+		t.Error("IsOnCurve(G) = false, want true")
+	}
+	if !IsOnCurve(ScalarBaseMult([]byte{0x02})) {
+		t.Error("IsOnCurve(2G) = false, want true")
+	}
+
+	offCurve := Generator()
+	offCurve.Y.Add(offCurve.Y, big.NewInt(1))
+	if IsOnCurve(offCurve) {
+		t.Error("IsOnCurve() of an off-curve point = true, want false")
+	}
+
+	unreduced := Generator()
+	unreduced.X.Add(unreduced.X, P)
+	if IsOnCurve(unreduced) {
+		t.Error("IsOnCurve() with X >= P = true, want false")
+	}
+
+	if IsOnCurve(Infinity()) || IsOnCurve(nil) {
+		t.Error("IsOnCurve() of infinity or nil = true, want false")
+	}
+}
+
 func TestPointClone(t *testing.T) {
 	p := Generator()
 	clone := p.Clone()
@@ -243,3 +268,40 @@ func hexToBytes(s string) []byte {
 	b, _ := hex.DecodeString(s)
 	return b
 }
+
+func TestPrivateKeyFromBytes(t *testing.T) {
+	// N + 5 reduces to 5
+	input := new(big.Int).Add(N, big.NewInt(5)).Bytes()
+	key, err := PrivateKeyFromBytes(input)
+	if err != nil {
+		t.Fatalf("PrivateKeyFromBytes() error = %v", err)
+	}
+	if len(key) != 32 || new(big.Int).SetBytes(key).Int64() != 5 {
+		t.Errorf("PrivateKeyFromBytes(N+5) = %x, want 5 padded to 32 bytes", key)
+	}
+
+	// 64 bytes of 0xff is reduced below N
+	wide := make([]byte, 64)
+	for i := range wide {
+		wide[i] = 0xff
+	}
+	key, err = PrivateKeyFromBytes(wide)
+	if err != nil {
+		t.Fatalf("PrivateKeyFromBytes() error = %v", err)
+	}
+	if len(key) != 32 || !IsValidPrivateKey(key) {
+		t.Errorf("PrivateKeyFromBytes(64 bytes) = %x, not a valid key", key)
+	}
+
+	// Short input is left-padded
+	key, _ = PrivateKeyFromBytes([]byte{0x01})
+	if len(key) != 32 || key[31] != 1 {
+		t.Errorf("PrivateKeyFromBytes(0x01) = %x", key)
+	}
+
+	for _, zero := range [][]byte{nil, make([]byte, 32), N.Bytes()} {
+		if _, err := PrivateKeyFromBytes(zero); err != ErrInvalidPrivKey {
+			t.Errorf("PrivateKeyFromBytes(%x) error = %v, want ErrInvalidPrivKey", zero, err)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package secp256k1
+
+import (
+	"crypto/sha256"
+	"math/big"
+)
+
+// ECDH computes the shared point privKey*pubKey and returns it compressed (33 bytes).
+// Protocols that use only the X coordinate can take bytes [1:].
+func ECDH(privKey []byte, pubKey *Point) ([]byte, error) {
+	if !IsValidPrivateKey(privKey) {
+		return nil, ErrInvalidPrivKey
+	}
+	if pubKey == nil || pubKey.IsInfinity() || !IsOnCurve(pubKey) {
+		return nil, ErrInvalidPublicKey
+	}
+
+	shared := ScalarMult(pubKey, new(big.Int).SetBytes(privKey))
+	if shared.IsInfinity() {
+		return nil, ErrInvalidPublicKey
+	}
+
+	return CompressPoint(shared), nil
+}
+
+// ECDHHashed returns SHA-256 of the compressed shared point, matching the
+// default secret derivation of libsecp256k1's ECDH module.
+func ECDHHashed(privKey []byte, pubKey *Point) ([]byte, error) {
+	shared, err := ECDH(privKey, pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(shared)
+	return hash[:], nil
+}
@@ -0,0 +1,58 @@
+package secp256k1
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+)
+
+func TestECDH(t *testing.T) {
+	alicePriv, _ := hex.DecodeString("e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35")
+	bobPriv, _ := hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000003")
+
+	alicePub := PrivateKeyToPublicKey(alicePriv)
+	bobPub := PrivateKeyToPublicKey(bobPriv)
+
+	aliceShared, err := ECDH(alicePriv, bobPub)
+	if err != nil {
+		t.Fatalf("ECDH() error = %v", err)
+	}
+	bobShared, err := ECDH(bobPriv, alicePub)
+	if err != nil {
+		t.Fatalf("ECDH() error = %v", err)
+	}
+
+	if !bytes.Equal(aliceShared, bobShared) {
+		t.Errorf("ECDH(a, B) = %x, ECDH(b, A) = %x", aliceShared, bobShared)
+	}
+
+	// a*(3G) == 3*(aG)
+	want := CompressPoint(ScalarMult(alicePub, big.NewInt(3)))
+	if !bytes.Equal(aliceShared, want) {
+		t.Errorf("ECDH() = %x, want %x", aliceShared, want)
+	}
+
+	aliceHashed, _ := ECDHHashed(alicePriv, bobPub)
+	bobHashed, _ := ECDHHashed(bobPriv, alicePub)
+	if len(aliceHashed) != 32 || !bytes.Equal(aliceHashed, bobHashed) {
+		t.Errorf("ECDHHashed() mismatch: %x vs %x", aliceHashed, bobHashed)
+	}
+}
+
+func TestECDHInvalidInput(t *testing.T) {
+	priv, _ := hex.DecodeString("e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35")
+
+	if _, err := ECDH(make([]byte, 32), Generator()); err != ErrInvalidPrivKey {
+		t.Errorf("ECDH(zero key) error = %v, want ErrInvalidPrivKey", err)
+	}
+
+	offCurve := &Point{X: big.NewInt(1), Y: big.NewInt(1)}
+	if _, err := ECDH(priv, offCurve); err != ErrInvalidPublicKey {
+		t.Errorf("ECDH(off-curve point) error = %v, want ErrInvalidPublicKey", err)
+	}
+
+	if _, err := ECDH(priv, Infinity()); err != ErrInvalidPublicKey {
+		t.Errorf("ECDH(infinity) error = %v, want ErrInvalidPublicKey", err)
+	}
+}
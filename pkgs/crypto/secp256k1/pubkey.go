@@ -12,6 +12,12 @@ const (
 	// UncompressedPubKeyLen is the length of an uncompressed public key
 	UncompressedPubKeyLen = 65
 
+	// XOnlyPubKeyLen is the length of a BIP-340 x-only public key
+	XOnlyPubKeyLen = 32
+
+	// RawPubKeyLen is the length of an uncompressed public key without its prefix (X || Y)
+	RawPubKeyLen = 64
+
 	// PrefixEven is the prefix for compressed public keys with even Y
 	PrefixEven byte = 0x02
 
@@ -24,6 +30,8 @@ const (
 
 var (
 	ErrInvalidPublicKey = errors.New("invalid public key")
+	ErrInvalidTweak     = errors.New("invalid tweak")
+	ErrInvalidPrivKey   = errors.New("invalid private key")
 )
 
 // CompressPoint compresses an elliptic curve point to 33 bytes.
@@ -53,11 +61,35 @@ func DecompressPoint(compressed []byte) (*Point, error) {
 		return nil, ErrInvalidPublicKey
 	}
 
-	x := new(big.Int).SetBytes(compressed[1:])
+	point, err := LiftX(compressed[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	// LiftX returns the even-Y point; flip it if the prefix asks for odd Y
+	if prefix == PrefixOdd {
+		point.Y.Sub(P, point.Y)
+	}
+
+	return point, nil
+}
+
+// LiftX returns the point with the given 32-byte x-coordinate and an even Y
+// (BIP-340 lift_x). Taproot and Schnorr use these x-only public keys, which
+// unlike compressed keys carry no parity byte.
+func LiftX(x []byte) (*Point, error) {
+	if len(x) != 32 {
+		return nil, ErrInvalidPublicKey
+	}
+
+	px := new(big.Int).SetBytes(x)
+	if px.Cmp(P) >= 0 {
+		return nil, ErrInvalidPublicKey
+	}
 
 	// y^2 = x^3 + 7 (secp256k1: a=0, b=7)
-	x3 := new(big.Int).Exp(x, big.NewInt(3), P)
-	y2 := new(big.Int).Add(x3, big.NewInt(7))
+	y2 := new(big.Int).Exp(px, big.NewInt(3), P)
+	y2.Add(y2, big.NewInt(7))
 	y2.Mod(y2, P)
 
 	// y = sqrt(y^2) mod P
@@ -66,18 +98,15 @@ func DecompressPoint(compressed []byte) (*Point, error) {
 		return nil, ErrInvalidPublicKey
 	}
 
-	// Choose correct y based on prefix
-	yIsOdd := y.Bit(0) == 1
-	prefixIndicatesOdd := prefix == PrefixOdd
-
-	if yIsOdd != prefixIndicatesOdd {
+	if y.Bit(0) == 1 {
 		y.Sub(P, y)
 	}
 
-	return &Point{X: x, Y: y}, nil
+	return &Point{X: px, Y: y}, nil
 }
 
 // ParsePublicKey parses a public key from bytes (compressed or uncompressed).
+// Uncompressed keys must lie on the curve.
 func ParsePublicKey(data []byte) (*Point, error) {
 	switch len(data) {
 	case CompressedPubKeyLen:
@@ -89,13 +118,53 @@ func ParsePublicKey(data []byte) (*Point, error) {
 		}
 		x := new(big.Int).SetBytes(data[1:33])
 		y := new(big.Int).SetBytes(data[33:65])
-		return &Point{X: x, Y: y}, nil
+		p := &Point{X: x, Y: y}
+		if !IsOnCurve(p) {
+			return nil, ErrInvalidPublicKey
+		}
+		return p, nil
 
 	default:
 		return nil, ErrInvalidPublicKey
 	}
 }
 
+// ParseAnyPublicKey parses a public key in any common encoding: x-only
+// (32 bytes, even Y), compressed (33), raw X || Y (64) or uncompressed (65).
+// Uncompressed forms are checked to lie on the curve.
+func ParseAnyPublicKey(data []byte) (*Point, error) {
+	var p *Point
+	switch len(data) {
+	case XOnlyPubKeyLen:
+		return LiftX(data)
+	case CompressedPubKeyLen:
+		return DecompressPoint(data)
+	case RawPubKeyLen:
+		p = &Point{X: new(big.Int).SetBytes(data[:32]), Y: new(big.Int).SetBytes(data[32:])}
+	case UncompressedPubKeyLen:
+		var err error
+		if p, err = ParsePublicKey(data); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrInvalidPublicKey
+	}
+
+	if !IsOnCurve(p) {
+		return nil, ErrInvalidPublicKey
+	}
+	return p, nil
+}
+
+// PublicKeyFormats returns a point in compressed (33 bytes), uncompressed
+// (65 bytes) and BIP-340 x-only (32 bytes) form.
+func PublicKeyFormats(p *Point) (compressed, uncompressed, xOnly []byte) {
+	compressed = CompressPoint(p)
+	uncompressed = SerializeUncompressed(p)
+	xOnly = p.X.FillBytes(make([]byte, XOnlyPubKeyLen))
+	return compressed, uncompressed, xOnly
+}
+
 // SerializeUncompressed serializes a point to 65-byte uncompressed format.
 func SerializeUncompressed(p *Point) []byte {
 	result := make([]byte, UncompressedPubKeyLen)
@@ -120,3 +189,20 @@ func PrivateKeyToCompressedPublicKey(privateKey []byte) []byte {
 	point := ScalarBaseMult(privateKey)
 	return CompressPoint(point)
 }
+
+// TweakAddPublicKey computes pub + tweak*G, as used by BIP-32 public derivation
+// and stealth addresses. The tweak must be less than N and the result must not
+// be the point at infinity.
+func TweakAddPublicKey(pub *Point, tweak []byte) (*Point, error) {
+	t := new(big.Int).SetBytes(tweak)
+	if t.Cmp(N) >= 0 {
+		return nil, ErrInvalidTweak
+	}
+
+	result := AddPoints(pub, ScalarBaseMult(tweak))
+	if result.IsInfinity() {
+		return nil, ErrInvalidTweak
+	}
+
+	return result, nil
+}
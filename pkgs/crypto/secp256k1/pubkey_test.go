@@ -3,6 +3,7 @@ package secp256k1
 import (
 	"bytes"
 	"encoding/hex"
+	"math/big"
 	"testing"
 )
 
@@ -169,6 +170,11 @@ func TestParsePublicKeyErrors(t *testing.T) {
 			input:   append([]byte{0x05}, make([]byte, 64)...),
 			wantErr: true,
 		},
+		{
+			name:    "uncompressed off curve",
+			input:   offCurveUncompressed(),
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -181,6 +187,13 @@ func TestParsePublicKeyErrors(t *testing.T) {
 	}
 }
 
+// offCurveUncompressed returns the generator serialized with Y off by one
+func offCurveUncompressed() []byte {
+	g := Generator()
+	g.Y.Add(g.Y, big.NewInt(1))
+	return SerializeUncompressed(g)
+}
+
 func TestPrivateKeyToPublicKey(t *testing.T) {
 	privKey, _ := hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000001")
 
@@ -269,3 +282,121 @@ func padTo32(b []byte) []byte {
 	copy(padded[32-len(b):], b)
 	return padded
 }
+
+func TestTweakAddPublicKey(t *testing.T) {
+	tweak, _ := hex.DecodeString("e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35")
+
+	got, err := TweakAddPublicKey(Generator(), tweak)
+	if err != nil {
+		t.Fatalf("TweakAddPublicKey() error = %v", err)
+	}
+
+	// G + k*G == (1+k)*G
+	one := make([]byte, 32)
+	one[31] = 1
+	want := PrivateKeyToPublicKey(AddPrivateKeys(one, tweak))
+	if !got.Equal(want) {
+		t.Errorf("TweakAddPublicKey(G, k) = %x, want %x", CompressPoint(got), CompressPoint(want))
+	}
+
+	if !AddPoints(Generator(), Generator()).Equal(Double(Generator())) {
+		t.Error("AddPoints(G, G) should equal Double(G)")
+	}
+
+	if _, err := TweakAddPublicKey(Generator(), N.Bytes()); err != ErrInvalidTweak {
+		t.Errorf("TweakAddPublicKey(G, N) error = %v, want ErrInvalidTweak", err)
+	}
+
+	// G + (N-1)*G is the point at infinity
+	nMinusOne := new(big.Int).Sub(N, big.NewInt(1))
+	if _, err := TweakAddPublicKey(Generator(), nMinusOne.Bytes()); err != ErrInvalidTweak {
+		t.Errorf("TweakAddPublicKey(G, N-1) error = %v, want ErrInvalidTweak", err)
+	}
+}
+
+func TestLiftX(t *testing.T) {
+	// Gy is even, so lifting Gx gives G itself
+	p, err := LiftX(Gx.FillBytes(make([]byte, 32)))
+	if err != nil {
+		t.Fatalf("LiftX(Gx) error = %v", err)
+	}
+	if !p.Equal(Generator()) {
+		t.Errorf("LiftX(Gx) = (%x, %x), want G", p.X, p.Y)
+	}
+
+	// For a point with odd Y, LiftX returns its negation
+	odd := Generator()
+	for odd.Y.Bit(0) == 0 {
+		odd = Add(odd, Generator())
+	}
+	p, err = LiftX(odd.X.FillBytes(make([]byte, 32)))
+	if err != nil {
+		t.Fatalf("LiftX() error = %v", err)
+	}
+	if p.Y.Bit(0) != 0 || new(big.Int).Add(p.Y, odd.Y).Cmp(P) != 0 {
+		t.Errorf("LiftX().Y = %x, want even negation of %x", p.Y, odd.Y)
+	}
+
+	// BIP-340 test vectors 5 and 14
+	tests := []struct {
+		name string
+		x    string
+	}{
+		{"not on curve", "eefdea4cdb677750a420fee807eacf21eb9898ae79b9768766e4faa04a2d4a34"},
+		{"exceeds field size", "fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc30"},
+		{"wrong length", "79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f817"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, _ := hex.DecodeString(tt.x)
+			if _, err := LiftX(x); err != ErrInvalidPublicKey {
+				t.Errorf("LiftX() error = %v, want %v", err, ErrInvalidPublicKey)
+			}
+		})
+	}
+}
+
+func TestParseAnyPublicKey(t *testing.T) {
+	// Use a point with odd Y so x-only parsing (even Y) gives its negation
+	odd := Generator()
+	for odd.Y.Bit(0) == 0 {
+		odd = Add(odd, Generator())
+	}
+	negated := &Point{X: odd.X, Y: new(big.Int).Sub(P, odd.Y)}
+
+	compressed, uncompressed, xOnly := PublicKeyFormats(odd)
+	if len(compressed) != 33 || len(uncompressed) != 65 || len(xOnly) != 32 {
+		t.Fatalf("PublicKeyFormats() lengths = %d, %d, %d", len(compressed), len(uncompressed), len(xOnly))
+	}
+
+	tests := []struct {
+		name  string
+		input []byte
+		want  *Point
+	}{
+		{"x-only", xOnly, negated},
+		{"compressed", compressed, odd},
+		{"raw", uncompressed[1:], odd},
+		{"uncompressed", uncompressed, odd},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParseAnyPublicKey(tt.input)
+			if err != nil {
+				t.Fatalf("ParseAnyPublicKey() error = %v", err)
+			}
+			if !p.Equal(tt.want) {
+				t.Errorf("ParseAnyPublicKey() = (%x, %x), want (%x, %x)", p.X, p.Y, tt.want.X, tt.want.Y)
+			}
+		})
+	}
+
+	// Off-curve and wrongly sized input
+	offCurve := append([]byte{}, uncompressed...)
+	offCurve[64] ^= 1
+	for _, input := range [][]byte{offCurve, offCurve[1:], make([]byte, 31), make([]byte, 66)} {
+		if _, err := ParseAnyPublicKey(input); err != ErrInvalidPublicKey {
+			t.Errorf("ParseAnyPublicKey(%d bytes) error = %v, want %v", len(input), err, ErrInvalidPublicKey)
+		}
+	}
+}
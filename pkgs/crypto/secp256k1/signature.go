@@ -0,0 +1,182 @@
+package secp256k1
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+)
+
+var (
+	// ErrInvalidHash is returned when the message hash is not 32 bytes
+	ErrInvalidHash = errors.New("message hash must be 32 bytes")
+
+	// ErrInvalidSignature is returned when no public key can be recovered from a signature
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+// halfN is N / 2, the largest S value in the low-S canonical form
+var halfN = new(big.Int).Rsh(N, 1)
+
+// Signature is an ECDSA signature over secp256k1.
+type Signature struct {
+	R, S *big.Int
+}
+
+// Sign produces a low-S ECDSA signature of a 32-byte message hash, using a
+// deterministic RFC 6979 nonce (HMAC-SHA256).
+func Sign(privKey, hash []byte) (*Signature, error) {
+	if !IsValidPrivateKey(privKey) {
+		return nil, ErrInvalidPrivKey
+	}
+	if len(hash) != 32 {
+		return nil, ErrInvalidHash
+	}
+
+	d := new(big.Int).SetBytes(privKey)
+	e := new(big.Int).SetBytes(hash)
+
+	nonces := newRFC6979(d, e)
+	for {
+		k := nonces.next()
+
+		r := new(big.Int).Mod(ScalarBaseMult(k.Bytes()).X, N)
+		if r.Sign() == 0 {
+			continue
+		}
+
+		// s = k^-1 * (e + r*d) mod N
+		s := new(big.Int).Mul(r, d)
+		s.Add(s, e)
+		s.Mul(s, new(big.Int).ModInverse(k, N))
+		s.Mod(s, N)
+		if s.Sign() == 0 {
+			continue
+		}
+
+		sig := &Signature{R: r, S: s}
+		NormalizeS(sig)
+		return sig, nil
+	}
+}
+
+// Verify reports whether sig is a valid ECDSA signature of hash under pubKey.
+// Both low-S and high-S signatures are accepted; use IsLowS to enforce BIP-62.
+func Verify(pubKey *Point, hash []byte, sig *Signature) bool {
+	if pubKey == nil || pubKey.IsInfinity() || !IsOnCurve(pubKey) || len(hash) != 32 {
+		return false
+	}
+	if sig == nil || sig.R == nil || sig.S == nil {
+		return false
+	}
+	if sig.R.Sign() <= 0 || sig.R.Cmp(N) >= 0 || sig.S.Sign() <= 0 || sig.S.Cmp(N) >= 0 {
+		return false
+	}
+
+	e := new(big.Int).SetBytes(hash)
+	w := new(big.Int).ModInverse(sig.S, N)
+	u1 := new(big.Int).Mod(new(big.Int).Mul(e, w), N)
+	u2 := new(big.Int).Mod(new(big.Int).Mul(sig.R, w), N)
+
+	x := Add(ScalarMult(Generator(), u1), ScalarMult(pubKey, u2))
+	if x.IsInfinity() {
+		return false
+	}
+
+	return new(big.Int).Mod(x.X, N).Cmp(sig.R) == 0
+}
+
+// RecoverPublicKey returns the public key whose signature of hash is sig,
+// where recID is the parity of the Y coordinate of the nonce point R. Only
+// recovery ids 0 and 1 are supported; ids 2 and 3, for R.X >= N, practically
+// never occur.
+func RecoverPublicKey(hash []byte, sig *Signature, recID byte) (*Point, error) {
+	if len(hash) != 32 {
+		return nil, ErrInvalidHash
+	}
+	if recID > 1 || sig == nil || sig.R == nil || sig.S == nil ||
+		sig.R.Sign() <= 0 || sig.R.Cmp(N) >= 0 || sig.S.Sign() <= 0 || sig.S.Cmp(N) >= 0 {
+		return nil, ErrInvalidSignature
+	}
+
+	r, err := LiftX(sig.R.FillBytes(make([]byte, 32)))
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+	if recID == 1 {
+		r.Y.Sub(P, r.Y)
+	}
+
+	// Q = r^-1 * (s*R - e*G)
+	rInv := new(big.Int).ModInverse(sig.R, N)
+	u1 := new(big.Int).Mul(new(big.Int).SetBytes(hash), rInv)
+	u1.Neg(u1).Mod(u1, N)
+	u2 := new(big.Int).Mul(sig.S, rInv)
+	u2.Mod(u2, N)
+
+	q := Add(ScalarMult(Generator(), u1), ScalarMult(r, u2))
+	if q.IsInfinity() {
+		return nil, ErrInvalidSignature
+	}
+	return q, nil
+}
+
+// NormalizeS rewrites sig into the low-S canonical form required by BIP-62 and
+// Ethereum (EIP-2) by replacing S with N - S when S is in the upper half of the
+// order. (R, S) and (R, N - S) verify against the same key and message.
+func NormalizeS(sig *Signature) {
+	if sig.S.Cmp(halfN) > 0 {
+		sig.S = new(big.Int).Sub(N, sig.S)
+	}
+}
+
+// IsLowS reports whether S is in the lower half of the curve order
+func (sig *Signature) IsLowS() bool {
+	return sig.S.Cmp(halfN) <= 0
+}
+
+// rfc6979 generates the deterministic nonce sequence of RFC 6979, section 3.2
+type rfc6979 struct {
+	k, v []byte
+}
+
+func newRFC6979(d, e *big.Int) *rfc6979 {
+	x := d.FillBytes(make([]byte, 32))
+	h1 := new(big.Int).Mod(e, N).FillBytes(make([]byte, 32))
+
+	g := &rfc6979{k: make([]byte, 32), v: make([]byte, 32)}
+	for i := range g.v {
+		g.v[i] = 0x01
+	}
+
+	g.k = g.mac(g.v, []byte{0x00}, x, h1)
+	g.v = g.mac(g.v)
+	g.k = g.mac(g.v, []byte{0x01}, x, h1)
+	g.v = g.mac(g.v)
+
+	return g
+}
+
+// next returns the next candidate nonce in [1, N-1]
+func (g *rfc6979) next() *big.Int {
+	for {
+		g.v = g.mac(g.v)
+		k := new(big.Int).SetBytes(g.v)
+
+		// Advance the state so a nonce the caller rejects isn't repeated
+		g.k = g.mac(g.v, []byte{0x00})
+		g.v = g.mac(g.v)
+
+		if k.Sign() > 0 && k.Cmp(N) < 0 {
+			return k
+		}
+	}
+}
+
+func (g *rfc6979) mac(parts ...[]byte) []byte {
+	h := hmac.New(sha256.New, g.k)
+	for _, p := range parts {
+		h.Write(p)
+	}
+	return h.Sum(nil)
+}
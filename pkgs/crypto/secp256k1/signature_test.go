@@ -0,0 +1,118 @@
+package secp256k1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"testing"
+)
+
+func TestSignRFC6979(t *testing.T) {
+	// Private key 1, message "Satoshi Nakamoto"
+	privKey := make([]byte, 32)
+	privKey[31] = 1
+	hash := sha256.Sum256([]byte("Satoshi Nakamoto"))
+
+	sig, err := Sign(privKey, hash[:])
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+
+	wantR := "934b1ea10a4b3c1757e2b0c017d0b6143ce3c9a7e6a4a49860d7a6ab210ee3d8"
+	wantS := "2442ce9d2b916064108014783e923ec36b49743e2ffa1c4496f01a512aafd9e5"
+	if got := hex.EncodeToString(sig.R.FillBytes(make([]byte, 32))); got != wantR {
+		t.Errorf("Sign() R = %s, want %s", got, wantR)
+	}
+	if got := hex.EncodeToString(sig.S.FillBytes(make([]byte, 32))); got != wantS {
+		t.Errorf("Sign() S = %s, want %s", got, wantS)
+	}
+
+	if !Verify(PrivateKeyToPublicKey(privKey), hash[:], sig) {
+		t.Error("Verify() = false, want true")
+	}
+
+	other := sha256.Sum256([]byte("Satoshi Nakamoto!"))
+	if Verify(PrivateKeyToPublicKey(privKey), other[:], sig) {
+		t.Error("Verify() with a different hash = true, want false")
+	}
+}
+
+func TestNormalizeS(t *testing.T) {
+	privKey, _ := hex.DecodeString("e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35")
+	pubKey := PrivateKeyToPublicKey(privKey)
+	hash := sha256.Sum256([]byte("normalize"))
+
+	low, err := Sign(privKey, hash[:])
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+	if !low.IsLowS() {
+		t.Fatal("Sign() returned a high-S signature")
+	}
+
+	// The malleated form (R, N - S) is still a valid signature
+	high := &Signature{R: new(big.Int).Set(low.R), S: new(big.Int).Sub(N, low.S)}
+	if high.IsLowS() {
+		t.Fatal("N - S should be high-S")
+	}
+	if !Verify(pubKey, hash[:], high) {
+		t.Error("Verify(high-S) = false, want true")
+	}
+
+	NormalizeS(high)
+	if high.S.Cmp(low.S) != 0 || high.R.Cmp(low.R) != 0 {
+		t.Errorf("NormalizeS() = (%x, %x), want (%x, %x)", high.R, high.S, low.R, low.S)
+	}
+
+	// A low-S signature is left unchanged
+	s := new(big.Int).Set(low.S)
+	NormalizeS(low)
+	if low.S.Cmp(s) != 0 {
+		t.Errorf("NormalizeS() changed a low-S signature: %x, want %x", low.S, s)
+	}
+	if !Verify(pubKey, hash[:], low) {
+		t.Error("Verify(low-S) = false, want true")
+	}
+}
+
+func TestRecoverPublicKey(t *testing.T) {
+	privKey, _ := hex.DecodeString("e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35")
+	pubKey := PrivateKeyToPublicKey(privKey)
+
+	// Exactly one recovery id yields the signer's key
+	for _, msg := range []string{"recover", "recover again", "and again"} {
+		hash := sha256.Sum256([]byte(msg))
+		sig, err := Sign(privKey, hash[:])
+		if err != nil {
+			t.Fatalf("Sign() error = %v", err)
+		}
+
+		matches := 0
+		for recID := byte(0); recID <= 1; recID++ {
+			recovered, err := RecoverPublicKey(hash[:], sig, recID)
+			if err != nil {
+				t.Fatalf("RecoverPublicKey(%d) error = %v", recID, err)
+			}
+			if recovered.Equal(pubKey) {
+				matches++
+			} else if !Verify(recovered, hash[:], sig) {
+				t.Errorf("RecoverPublicKey(%d) = a key the signature does not verify under", recID)
+			}
+		}
+		if matches != 1 {
+			t.Errorf("%q: %d recovery ids yield the signer, want 1", msg, matches)
+		}
+	}
+
+	hash := sha256.Sum256([]byte("recover"))
+	sig, _ := Sign(privKey, hash[:])
+	if _, err := RecoverPublicKey(hash[:], sig, 2); err != ErrInvalidSignature {
+		t.Errorf("RecoverPublicKey(recID 2) error = %v, want %v", err, ErrInvalidSignature)
+	}
+	if _, err := RecoverPublicKey(hash[:], &Signature{R: new(big.Int).Set(N), S: sig.S}, 0); err != ErrInvalidSignature {
+		t.Errorf("RecoverPublicKey(R = N) error = %v, want %v", err, ErrInvalidSignature)
+	}
+	if _, err := RecoverPublicKey(hash[:31], sig, 0); err != ErrInvalidHash {
+		t.Errorf("RecoverPublicKey(31-byte hash) error = %v, want %v", err, ErrInvalidHash)
+	}
+}
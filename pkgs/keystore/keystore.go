@@ -0,0 +1,264 @@
+// Package keystore implements the Web3 Secret Storage (V3 keystore) format
+// Ethereum wallets such as geth use to store private keys as JSON.
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+
+	"github.com/study/crypto-accounts/pkgs/address"
+	"github.com/study/crypto-accounts/pkgs/crypto/kdf"
+	"github.com/study/crypto-accounts/pkgs/crypto/secp256k1"
+)
+
+// Version is the keystore format version this package reads and writes
+const Version = 3
+
+// scrypt parameters used by geth
+const (
+	// StandardScryptN and StandardScryptP are geth's default (256 MB) parameters
+	StandardScryptN = kdf.KeystoreStandardN
+	StandardScryptP = kdf.KeystoreStandardP
+
+	// LightScryptN and LightScryptP are geth's --lightkdf (4 MB) parameters
+	LightScryptN = kdf.KeystoreLightN
+	LightScryptP = kdf.KeystoreLightP
+)
+
+const (
+	scryptR      = 8
+	scryptKeyLen = 32
+)
+
+const cipherAES128CTR = "aes-128-ctr"
+
+// Limits on KDF parameters read from keystore files, which are untrusted.
+// They admit every parameter set geth writes while capping the memory and
+// time a crafted file can make Decrypt spend.
+const (
+	minDKLen      = 32
+	maxDKLen      = 64
+	maxScryptN    = 1 << 20 // 4x geth's standard N
+	maxScryptR    = 8
+	maxScryptP    = 16
+	maxPBKDF2Iter = 1 << 22
+)
+
+// Rand is the randomness source for salts, IVs and key IDs
+var Rand io.Reader = rand.Reader
+
+var (
+	// ErrInvalidPrivateKey is returned for keys outside the secp256k1 range
+	ErrInvalidPrivateKey = errors.New("keystore: invalid private key")
+
+	// ErrUnsupported is returned for versions, ciphers or KDFs this package does not handle
+	ErrUnsupported = errors.New("keystore: unsupported keystore")
+
+	// ErrWrongPassphrase is returned when the MAC does not match
+	ErrWrongPassphrase = errors.New("keystore: wrong passphrase")
+)
+
+// keyJSON is the V3 keystore file layout
+type keyJSON struct {
+	Address string     `json:"address,omitempty"`
+	Crypto  cryptoJSON `json:"crypto"`
+	ID      string     `json:"id"`
+	Version int        `json:"version"`
+}
+
+type cryptoJSON struct {
+	Cipher       string                 `json:"cipher"`
+	CipherText   string                 `json:"ciphertext"`
+	CipherParams cipherParamsJSON       `json:"cipherparams"`
+	KDF          string                 `json:"kdf"`
+	KDFParams    map[string]interface{} `json:"kdfparams"`
+	MAC          string                 `json:"mac"`
+}
+
+type cipherParamsJSON struct {
+	IV string `json:"iv"`
+}
+
+// Encrypt encrypts a 32-byte private key into V3 keystore JSON using scrypt
+// with geth's standard parameters.
+func Encrypt(privKey []byte, passphrase string) ([]byte, error) {
+	return EncryptWithScrypt(privKey, passphrase, StandardScryptN, StandardScryptP)
+}
+
+// EncryptWithScrypt is Encrypt with explicit scrypt N and P parameters
+func EncryptWithScrypt(privKey []byte, passphrase string, n, p int) ([]byte, error) {
+	if len(privKey) != 32 || !secp256k1.IsValidPrivateKey(privKey) {
+		return nil, ErrInvalidPrivateKey
+	}
+
+	salt := make([]byte, 32)
+	iv := make([]byte, aes.BlockSize)
+	id := make([]byte, 16)
+	for _, buf := range [][]byte{salt, iv, id} {
+		if _, err := io.ReadFull(Rand, buf); err != nil {
+			return nil, err
+		}
+	}
+
+	derived, err := kdf.DeriveKey([]byte(passphrase), salt, kdf.ScryptParams{N: n, R: scryptR, P: p}, scryptKeyLen)
+	if err != nil {
+		return nil, err
+	}
+
+	cipherText, err := aesCTR(derived[:16], iv, privKey)
+	if err != nil {
+		return nil, err
+	}
+
+	_, pubKey, _ := secp256k1.PublicKeyFormats(secp256k1.PrivateKeyToPublicKey(privKey))
+	addr, err := address.NewEthereumAddress().Generate(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(keyJSON{
+		Address: strings.ToLower(strings.TrimPrefix(addr, "0x")),
+		Crypto: cryptoJSON{
+			Cipher:       cipherAES128CTR,
+			CipherText:   hex.EncodeToString(cipherText),
+			CipherParams: cipherParamsJSON{IV: hex.EncodeToString(iv)},
+			KDF:          "scrypt",
+			KDFParams: map[string]interface{}{
+				"n":     n,
+				"r":     scryptR,
+				"p":     p,
+				"dklen": scryptKeyLen,
+				"salt":  hex.EncodeToString(salt),
+			},
+			MAC: hex.EncodeToString(mac(derived, cipherText)),
+		},
+		ID:      uuidV4(id),
+		Version: Version,
+	})
+}
+
+// Decrypt decrypts V3 keystore JSON, accepting both the scrypt and the
+// pbkdf2 (hmac-sha256) key derivation functions.
+func Decrypt(keyjson []byte, passphrase string) ([]byte, error) {
+	var k keyJSON
+	if err := json.Unmarshal(keyjson, &k); err != nil {
+		return nil, fmt.Errorf("keystore: %w", err)
+	}
+	if k.Version != Version {
+		return nil, fmt.Errorf("%w: version %d", ErrUnsupported, k.Version)
+	}
+	if k.Crypto.Cipher != cipherAES128CTR {
+		return nil, fmt.Errorf("%w: cipher %q", ErrUnsupported, k.Crypto.Cipher)
+	}
+
+	cipherText, err := hex.DecodeString(k.Crypto.CipherText)
+	if err != nil {
+		return nil, fmt.Errorf("keystore: ciphertext: %w", err)
+	}
+	iv, err := hex.DecodeString(k.Crypto.CipherParams.IV)
+	if err != nil || len(iv) != aes.BlockSize {
+		return nil, fmt.Errorf("keystore: invalid iv")
+	}
+	wantMAC, err := hex.DecodeString(k.Crypto.MAC)
+	if err != nil {
+		return nil, fmt.Errorf("keystore: mac: %w", err)
+	}
+
+	derived, err := deriveKey(k.Crypto, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(mac(derived, cipherText), wantMAC) {
+		return nil, ErrWrongPassphrase
+	}
+
+	privKey, err := aesCTR(derived[:16], iv, cipherText)
+	if err != nil {
+		return nil, err
+	}
+	if len(privKey) != 32 || !secp256k1.IsValidPrivateKey(privKey) {
+		return nil, ErrInvalidPrivateKey
+	}
+	return privKey, nil
+}
+
+// deriveKey runs the keystore's KDF over the passphrase
+func deriveKey(c cryptoJSON, passphrase string) ([]byte, error) {
+	saltHex, _ := c.KDFParams["salt"].(string)
+	salt, err := hex.DecodeString(saltHex)
+	if err != nil {
+		return nil, fmt.Errorf("keystore: salt: %w", err)
+	}
+	dkLen := intParam(c.KDFParams, "dklen")
+	if dkLen < minDKLen || dkLen > maxDKLen {
+		return nil, fmt.Errorf("%w: dklen %d", ErrUnsupported, dkLen)
+	}
+
+	switch c.KDF {
+	case "scrypt":
+		n, r, p := intParam(c.KDFParams, "n"), intParam(c.KDFParams, "r"), intParam(c.KDFParams, "p")
+		if n > maxScryptN || r > maxScryptR || p > maxScryptP {
+			return nil, fmt.Errorf("%w: scrypt n=%d r=%d p=%d", ErrUnsupported, n, r, p)
+		}
+		return kdf.DeriveKey([]byte(passphrase), salt, kdf.ScryptParams{N: n, R: r, P: p}, dkLen)
+	case "pbkdf2":
+		if prf, _ := c.KDFParams["prf"].(string); prf != "hmac-sha256" {
+			return nil, fmt.Errorf("%w: prf %q", ErrUnsupported, prf)
+		}
+		iter := intParam(c.KDFParams, "c")
+		if iter <= 0 || iter > maxPBKDF2Iter {
+			return nil, fmt.Errorf("%w: pbkdf2 c=%d", ErrUnsupported, iter)
+		}
+		return pbkdf2.Key([]byte(passphrase), salt, iter, dkLen, sha256.New), nil
+	default:
+		return nil, fmt.Errorf("%w: kdf %q", ErrUnsupported, c.KDF)
+	}
+}
+
+// intParam reads a numeric KDF parameter, which JSON decodes as float64.
+// Missing, fractional and out-of-range values read as -1.
+func intParam(params map[string]interface{}, name string) int {
+	v, ok := params[name].(float64)
+	if !ok || v != math.Trunc(v) || v < math.MinInt32 || v > math.MaxInt32 {
+		return -1
+	}
+	return int(v)
+}
+
+// mac is Keccak-256 of the second half of the derived key and the ciphertext
+func mac(derived, cipherText []byte) []byte {
+	data := make([]byte, 0, 16+len(cipherText))
+	data = append(data, derived[16:32]...)
+	data = append(data, cipherText...)
+	return address.Keccak256(data)
+}
+
+// aesCTR encrypts or decrypts data with AES-128 in CTR mode
+func aesCTR(key, iv, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(data))
+	cipher.NewCTR(block, iv).XORKeyStream(out, data)
+	return out, nil
+}
+
+// uuidV4 formats 16 random bytes as a version 4 UUID
+func uuidV4(b []byte) string {
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
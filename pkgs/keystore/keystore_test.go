@@ -0,0 +1,115 @@
+package keystore
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// Web3 Secret Storage Definition test vectors, password "testpassword"
+const (
+	testPrivKey = "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d"
+
+	pbkdf2Keystore = `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"6087dab2f9fdbbfaddc31a909735c1e6"},"ciphertext":"5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46","kdf":"pbkdf2","kdfparams":{"c":262144,"dklen":32,"prf":"hmac-sha256","salt":"ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"},"mac":"517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`
+
+	scryptKeystore = `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"83dbcc02d8ccb40e466191a123791e0e"},"ciphertext":"d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c","kdf":"scrypt","kdfparams":{"dklen":32,"n":262144,"r":1,"p":8,"salt":"ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"},"mac":"2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`
+)
+
+func TestDecrypt(t *testing.T) {
+	for _, keyjson := range []string{pbkdf2Keystore, scryptKeystore} {
+		privKey, err := Decrypt([]byte(keyjson), "testpassword")
+		if err != nil {
+			t.Fatalf("Decrypt() error = %v", err)
+		}
+		if hex.EncodeToString(privKey) != testPrivKey {
+			t.Errorf("Decrypt() = %x, want %s", privKey, testPrivKey)
+		}
+	}
+
+	if _, err := Decrypt([]byte(pbkdf2Keystore), "wrong"); !errors.Is(err, ErrWrongPassphrase) {
+		t.Errorf("Decrypt() with wrong passphrase error = %v, want ErrWrongPassphrase", err)
+	}
+}
+
+func TestEncryptRoundTrip(t *testing.T) {
+	privKey, _ := hex.DecodeString(testPrivKey)
+
+	keyjson, err := EncryptWithScrypt(privKey, "testpassword", LightScryptN, LightScryptP)
+	if err != nil {
+		t.Fatalf("EncryptWithScrypt() error = %v", err)
+	}
+
+	var k keyJSON
+	if err := json.Unmarshal(keyjson, &k); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if k.Version != Version || k.Crypto.KDF != "scrypt" || len(k.ID) != 36 {
+		t.Errorf("Encrypt() version = %d, kdf = %s, id = %s", k.Version, k.Crypto.KDF, k.ID)
+	}
+	if k.Address != "008aeeda4d805471df9b2a5b0f38a0c3bcba786b" {
+		t.Errorf("Encrypt() address = %s, want 008aeeda4d805471df9b2a5b0f38a0c3bcba786b", k.Address)
+	}
+
+	got, err := Decrypt(keyjson, "testpassword")
+	if err != nil {
+		t.Fatalf("Decrypt() error = %v", err)
+	}
+	if hex.EncodeToString(got) != testPrivKey {
+		t.Errorf("Decrypt(Encrypt()) = %x, want %s", got, testPrivKey)
+	}
+}
+
+func TestDecryptMalformedKDFParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to string
+		keyjson  string
+	}{
+		{"negative dklen", `"dklen":32`, `"dklen":-100`, pbkdf2Keystore},
+		{"huge dklen", `"dklen":32`, `"dklen":1e12`, pbkdf2Keystore},
+		{"huge pbkdf2 c", `"c":262144`, `"c":1e15`, pbkdf2Keystore},
+		{"zero pbkdf2 c", `"c":262144`, `"c":0`, pbkdf2Keystore},
+		{"huge scrypt n", `"n":262144`, `"n":1073741824`, scryptKeystore},
+		{"huge scrypt r", `"r":1`, `"r":1000000`, scryptKeystore},
+		{"huge scrypt p", `"p":8`, `"p":1000000`, scryptKeystore},
+		{"missing scrypt n", `"n":262144,`, ``, scryptKeystore},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyjson := strings.Replace(tt.keyjson, tt.from, tt.to, 1)
+			if keyjson == tt.keyjson {
+				t.Fatalf("test keystore has no %s", tt.from)
+			}
+			if _, err := Decrypt([]byte(keyjson), "testpassword"); err == nil {
+				t.Errorf("Decrypt() with %s succeeded, want an error", tt.name)
+			}
+		})
+	}
+}
+
+func TestDecryptRejectsInvalidKey(t *testing.T) {
+	// A correctly MACed keystore whose plaintext is the zero scalar
+	c := cryptoJSON{
+		KDF:       "pbkdf2",
+		KDFParams: map[string]interface{}{"c": 1.0, "dklen": 32.0, "prf": "hmac-sha256", "salt": "00"},
+	}
+	derived, err := deriveKey(c, "testpassword")
+	if err != nil {
+		t.Fatalf("deriveKey() error = %v", err)
+	}
+	iv := make([]byte, 16)
+	cipherText, _ := aesCTR(derived[:16], iv, make([]byte, 32))
+
+	c.Cipher = cipherAES128CTR
+	c.CipherText = hex.EncodeToString(cipherText)
+	c.CipherParams = cipherParamsJSON{IV: hex.EncodeToString(iv)}
+	c.MAC = hex.EncodeToString(mac(derived, cipherText))
+	keyjson, _ := json.Marshal(keyJSON{Crypto: c, Version: Version})
+
+	if _, err := Decrypt(keyjson, "testpassword"); !errors.Is(err, ErrInvalidPrivateKey) {
+		t.Errorf("Decrypt() of a zero key error = %v, want ErrInvalidPrivateKey", err)
+	}
+}